@@ -0,0 +1,3 @@
+# import API keys by application ID and API key ID.
+# The secret is only returned on creation, so is not available after import
+tofu import kaleido_platform_api_key.api_key "ap:1234abcd/ak:5678efgh"
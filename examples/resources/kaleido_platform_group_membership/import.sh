@@ -0,0 +1,2 @@
+# import group memberships by group ID and user ID
+tofu import kaleido_platform_group_membership.user_group_membership "g:1234abcd/u:5678efgh"
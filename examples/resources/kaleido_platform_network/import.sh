@@ -0,0 +1,2 @@
+# import networks by environment ID and network ID
+tofu import kaleido_platform_network.network "e:1234abcd/n:5678efgh"
@@ -0,0 +1,2 @@
+# import runtimes by environment ID and runtime ID
+tofu import kaleido_platform_runtime.bnr "e:1234abcd/r:5678efgh"
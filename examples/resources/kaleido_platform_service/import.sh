@@ -0,0 +1,2 @@
+# import services by environment ID and service ID
+tofu import kaleido_platform_service.bns "e:1234abcd/s:5678efgh"
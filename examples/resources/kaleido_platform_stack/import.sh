@@ -0,0 +1,2 @@
+# import stacks by environment ID and stack ID
+tofu import kaleido_platform_stack.chain_infra_besu_stack "e:1234abcd/st:5678efgh"
@@ -0,0 +1,108 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleido
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kaleido "github.com/kaleido-io/kaleido-sdk-go/kaleido"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+)
+
+type actionServiceReset struct {
+	baasBaseAction
+}
+
+func ActionServiceResetFactory() action.Action {
+	return &actionServiceReset{}
+}
+
+type ServiceResetActionModel struct {
+	ConsortiumID  types.String `tfsdk:"consortium_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	ServiceID     types.String `tfsdk:"service_id"`
+}
+
+func (a *actionServiceReset) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "kaleido_service_reset"
+}
+
+func (a *actionServiceReset) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resets a service, and waits for it to be started again. Use this instead of changing `update_trigger` on a `kaleido_service`, to reset the service without a change to its state.",
+		Attributes: map[string]schema.Attribute{
+			"consortium_id": &schema.StringAttribute{
+				Required: true,
+			},
+			"environment_id": &schema.StringAttribute{
+				Required: true,
+			},
+			"service_id": &schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *actionServiceReset) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ServiceResetActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	consortiumID := data.ConsortiumID.ValueString()
+	environmentID := data.EnvironmentID.ValueString()
+	serviceID := data.ServiceID.ValueString()
+
+	res, err := a.BaaS.ResetService(consortiumID, environmentID, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to reset service", err.Error())
+		return
+	}
+	status := res.StatusCode()
+	if status != 200 {
+		msg := "Could not reset service %s in consortium %s in environment %s with status %d: %s"
+		resp.Diagnostics.AddError("failed to reset service", fmt.Sprintf(msg, serviceID, consortiumID, environmentID, status, res.String()))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for service %s to start", serviceID)})
+	err = a.Retry(kaleidobase.DefaultRetry).Do(ctx, "Reset", func(attempt int) (retry bool, err error) {
+		var apiModel kaleido.Service
+		res, getErr := a.BaaS.GetService(consortiumID, environmentID, serviceID, &apiModel)
+		if getErr != nil {
+			return false, getErr
+		}
+
+		statusCode := res.StatusCode()
+		if statusCode != 200 {
+			return false, fmt.Errorf("fetching service %s state failed: %d", serviceID, statusCode)
+		}
+
+		if apiModel.State != "started" {
+			msg := "service %s in environment %s in consortium %s " +
+				"took too long to enter state 'started'. Final state was '%s'"
+			return true, fmt.Errorf(msg, serviceID, environmentID, consortiumID, apiModel.State)
+		}
+		return false, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to query service status", err.Error())
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleido
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	kaleido "github.com/kaleido-io/kaleido-sdk-go/kaleido"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func testInvokeServiceReset(t *testing.T) action.InvokeResponse {
+	ctx := context.Background()
+	a := ActionServiceResetFactory()
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	baas := kaleido.NewClient("http://example.com/api/v1", "apikey1")
+	var configureResp action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: &kaleidobase.ProviderData{BaaS: &baas}}, &configureResp)
+	assert.False(t, configureResp.Diagnostics.HasError())
+
+	resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"consortium_id":  tftypes.NewValue(tftypes.String, "cons1"),
+				"environment_id": tftypes.NewValue(tftypes.String, "env1"),
+				"service_id":     tftypes.NewValue(tftypes.String, "svc1"),
+			}),
+		},
+	}, &resp)
+	return resp
+}
+
+func TestKaleidoServiceResetAction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com").
+		Put("/api/v1/consortia/cons1/environments/env1/services/svc1/reset").
+		Reply(200).
+		JSON(map[string]string{})
+
+	gock.New("http://example.com").
+		Get("/api/v1/consortia/cons1/environments/env1/services/svc1").
+		Reply(200).
+		JSON(map[string]string{"_id": "svc1", "state": "started"})
+
+	resp := testInvokeServiceReset(t)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, gock.IsDone())
+}
+
+func TestKaleidoServiceResetActionFail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com").
+		Put("/api/v1/consortia/cons1/environments/env1/services/svc1/reset").
+		Reply(404).
+		JSON(map[string]string{"errorMessage": "not found"})
+
+	resp := testInvokeServiceReset(t)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "failed to reset service", resp.Diagnostics[0].Summary())
+}
@@ -0,0 +1,163 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleidobase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// HTTPRetry is the policy for retrying individual requests to the platform API that fail
+// with a transient error, as opposed to CustomRetry which polls long-running operations.
+type HTTPRetry struct {
+	MaxRetries int
+	MaxDelay   time.Duration
+}
+
+var DefaultHTTPRetry = HTTPRetry{
+	MaxRetries: 5,
+	MaxDelay:   30 * time.Second,
+}
+
+// ApplyTo sets the retry count and maximum delay on a resty client
+func (h HTTPRetry) ApplyTo(c *resty.Client) {
+	c.SetRetryCount(h.MaxRetries).
+		SetRetryMaxWaitTime(h.MaxDelay)
+}
+
+// ParseHTTPRetry validates the platform_http_retry_ settings in the provider configuration
+func (conf *ProviderModel) ParseHTTPRetry(diagnostics *diag.Diagnostics) HTTPRetry {
+	h := DefaultHTTPRetry
+	if !conf.PlatformHTTPRetryMaxRetries.IsNull() && !conf.PlatformHTTPRetryMaxRetries.IsUnknown() {
+		h.MaxRetries = int(conf.PlatformHTTPRetryMaxRetries.ValueInt64())
+		if h.MaxRetries < 0 {
+			diagnostics.AddAttributeError(path.Root("platform_http_retry_max_retries"), "Invalid HTTP max retries", fmt.Sprintf("platform_http_retry_max_retries must be 0 or more, got: %d", h.MaxRetries))
+		}
+	}
+	if maxDelay := parseRetryDuration("platform_http_retry_max_delay", conf.PlatformHTTPRetryMaxDelay, diagnostics); maxDelay != nil {
+		h.MaxDelay = *maxDelay
+	}
+	return h
+}
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey marks requests made with the returned context as safe to retry, even
+// when the method is not idempotent, because the server de-duplicates them using the key
+func WithIdempotencyKey(ctx context.Context, idempotencyKey string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, idempotencyKey)
+}
+
+func isRetryableRequest(req *resty.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	idempotencyKey, _ := req.Context().Value(idempotencyKeyContextKey{}).(string)
+	return idempotencyKey != ""
+}
+
+func isTransientStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		(errors.As(err, &netErr) && netErr.Timeout())
+}
+
+// platformRetryCondition retries rate limited requests, and requests that are safe to
+// repeat when they fail with a transient gateway error or a dropped connection
+func platformRetryCondition(r *resty.Response, err error) bool {
+	if r == nil || r.Request == nil {
+		// Failed before the request was sent, such as obtaining an OAuth2 token
+		return false
+	}
+	if err != nil {
+		return isTransientError(err) && isRetryableRequest(r.Request)
+	}
+	if r.StatusCode() == http.StatusTooManyRequests {
+		return true
+	}
+	return isTransientStatus(r.StatusCode()) && isRetryableRequest(r.Request)
+}
+
+// parseRetryAfter parses a Retry-After header in either decimal seconds or HTTP-date form
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseFloat(header, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+func platformRetryAfter(logCtx context.Context) resty.RetryAfterFunc {
+	return func(c *resty.Client, r *resty.Response) (time.Duration, error) {
+		if r.StatusCode() == http.StatusUnauthorized {
+			// Retrying with a refreshed OAuth2 token - no need to back off
+			return time.Millisecond, nil
+		}
+		tflog.Debug(logCtx, fmt.Sprintf("retryAfter: %s", r.Header().Get("Retry-After")))
+		retryAfter, ok := parseRetryAfter(r.Header().Get("Retry-After"), time.Now())
+		if !ok {
+			if r.StatusCode() != http.StatusTooManyRequests {
+				// Exponential backoff from 1s for gateway errors and dropped connections
+				retryAfter = time.Second << min(max(r.Request.Attempt-1, 0), 10)
+			} else {
+				retryAfter = 1 * time.Second
+			}
+		}
+		// Uniform [0, 5s) jitter to de-sync up to 5 concurrent connections retrying up to 5 times.
+		// rand.NormFloat64 was wrong here: ~50% of draws are negative, producing a negative final
+		// duration → time.Sleep returns instantly → thundering herd back into the 429.
+		jitter := time.Duration(rand.Float64() * float64(5*time.Second))
+		return retryAfter + jitter, nil
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleidobase
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFlakyPlatform fails each request with the next status in failures, where a status
+// of -1 drops the connection without a response, then succeeds
+type mockFlakyPlatform struct {
+	server   *httptest.Server
+	lock     sync.Mutex
+	calls    int
+	failures []int
+}
+
+func startMockFlakyPlatform(t *testing.T, failures ...int) (*mockFlakyPlatform, *ProviderData) {
+	m := &mockFlakyPlatform{failures: failures}
+	m.server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.calls++
+		if m.calls > len(m.failures) {
+			res.WriteHeader(http.StatusOK)
+			return
+		}
+		switch status := m.failures[m.calls-1]; status {
+		case -1:
+			conn, _, err := res.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+		default:
+			res.Header().Set("Retry-After", "0")
+			res.WriteHeader(status)
+		}
+	}))
+	pd := NewProviderData(context.Background(), &ProviderModel{
+		PlatformAPI: types.StringValue(m.server.URL),
+	})
+	HTTPRetry{MaxRetries: 3, MaxDelay: 10 * time.Millisecond}.ApplyTo(pd.Platform)
+	return m, pd
+}
+
+func (m *mockFlakyPlatform) callCount() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.calls
+}
+
+func TestHTTPRetryIdempotentMethods(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			m, pd := startMockFlakyPlatform(t, http.StatusBadGateway, http.StatusServiceUnavailable, -1)
+			defer m.server.Close()
+
+			res, err := pd.Platform.R().Execute(method, "/api/v1/things")
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.StatusCode())
+			assert.Equal(t, 4, m.callCount())
+		})
+	}
+}
+
+func TestHTTPRetryGivesUp(t *testing.T) {
+	m, pd := startMockFlakyPlatform(t, 504, 504, 504, 504, 504)
+	defer m.server.Close()
+
+	res, err := pd.Platform.R().Get("/api/v1/things")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusGatewayTimeout, res.StatusCode())
+	assert.Equal(t, 4, m.callCount())
+}
+
+func TestHTTPRetryPOSTOnlyWithIdempotencyKey(t *testing.T) {
+	m, pd := startMockFlakyPlatform(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	defer m.server.Close()
+
+	res, err := pd.Platform.R().Post("/api/v1/things")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode())
+	assert.Equal(t, 1, m.callCount())
+
+	ctx := WithIdempotencyKey(context.Background(), "key1")
+	res, err = pd.Platform.R().SetContext(ctx).Post("/api/v1/things")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, 3, m.callCount())
+}
+
+func TestHTTPRetryPOSTNotRetriedOnDroppedConnection(t *testing.T) {
+	m, pd := startMockFlakyPlatform(t, -1)
+	defer m.server.Close()
+
+	_, err := pd.Platform.R().Post("/api/v1/things")
+	assert.Error(t, err)
+	assert.Equal(t, 1, m.callCount())
+}
+
+func TestHTTPRetryRateLimitAlwaysRetried(t *testing.T) {
+	m, pd := startMockFlakyPlatform(t, http.StatusTooManyRequests)
+	defer m.server.Close()
+
+	res, err := pd.Platform.R().Post("/api/v1/things")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, 2, m.callCount())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("1.5", now)
+	assert.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, d)
+
+	d, ok = parseRetryAfter("Thu, 01 Jan 2026 00:00:10 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, d)
+
+	d, ok = parseRetryAfter("Wed, 31 Dec 2025 23:59:00 GMT", now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("-1", now)
+	assert.False(t, ok)
+}
+
+func TestParseHTTPRetry(t *testing.T) {
+	var diags diag.Diagnostics
+	h := (&ProviderModel{}).ParseHTTPRetry(&diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, DefaultHTTPRetry, h)
+
+	h = (&ProviderModel{
+		PlatformHTTPRetryMaxRetries: types.Int64Value(0),
+		PlatformHTTPRetryMaxDelay:   types.StringValue("2m"),
+	}).ParseHTTPRetry(&diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, HTTPRetry{MaxRetries: 0, MaxDelay: 2 * time.Minute}, h)
+
+	_ = (&ProviderModel{
+		PlatformHTTPRetryMaxRetries: types.Int64Value(-1),
+		PlatformHTTPRetryMaxDelay:   types.StringValue("0s"),
+	}).ParseHTTPRetry(&diags)
+	assert.Equal(t, 2, diags.ErrorsCount())
+}
@@ -0,0 +1,168 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleidobase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Tokens are refreshed this long before the expiry reported by the token endpoint,
+// so a request never goes out with a token that expires while in flight.
+const oauth2ExpiryLeeway = 30 * time.Second
+
+// Used when the token endpoint does not return an expires_in
+const oauth2DefaultExpiry = 5 * time.Minute
+
+type OAuth2Config struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type,omitempty"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+}
+
+// OAuth2TokenSource fetches access tokens using the OAuth2 client credentials grant,
+// and caches them until shortly before they expire. A single token source is shared
+// by every request made through the platform client.
+type OAuth2TokenSource struct {
+	conf   OAuth2Config
+	client *resty.Client
+	now    func() time.Time
+
+	lock   sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func NewOAuth2TokenSource(conf OAuth2Config, transport http.RoundTripper) *OAuth2TokenSource {
+	return &OAuth2TokenSource{
+		conf: conf,
+		client: resty.New().
+			SetTransport(transport).
+			SetHeader("User-Agent", fmt.Sprintf("Terraform / %s (Platform)", version)),
+		now: time.Now,
+	}
+}
+
+// Token returns the cached access token, fetching a new one if there is no token
+// cached or the cached token is about to expire.
+func (ts *OAuth2TokenSource) Token(ctx context.Context) (string, error) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	if ts.token != "" && ts.now().Before(ts.expiry.Add(-oauth2ExpiryLeeway)) {
+		return ts.token, nil
+	}
+	return ts.fetchToken(ctx)
+}
+
+// Expiry returns when the cached access token expires, as reported by the token endpoint.
+func (ts *OAuth2TokenSource) Expiry() time.Time {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	return ts.expiry
+}
+
+// Invalidate discards the cached token, if it is still the one that was rejected.
+// A token that has already been replaced (by a concurrent refresh) is left alone.
+func (ts *OAuth2TokenSource) Invalidate(rejected string) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	if ts.token == rejected {
+		ts.token = ""
+		ts.expiry = time.Time{}
+	}
+}
+
+func (ts *OAuth2TokenSource) fetchToken(ctx context.Context) (string, error) {
+	if ts.conf.ClientID == "" || ts.conf.ClientSecret == "" {
+		return "", fmt.Errorf("platform_oauth2_client_id and platform_oauth2_client_secret must be set to use platform_oauth2_token_url")
+	}
+
+	form := map[string]string{
+		"grant_type": "client_credentials",
+	}
+	if len(ts.conf.Scopes) > 0 {
+		form["scope"] = strings.Join(ts.conf.Scopes, " ")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("--> POST %s (client_credentials)", ts.conf.TokenURL))
+	res, err := ts.client.R().
+		SetContext(ctx).
+		SetBasicAuth(ts.conf.ClientID, ts.conf.ClientSecret).
+		SetHeader("Accept", "application/json").
+		SetFormData(form).
+		Post(ts.conf.TokenURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain OAuth2 access token from %s: %s", ts.conf.TokenURL, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("<-- POST %s [%d]", ts.conf.TokenURL, res.StatusCode()))
+	if !res.IsSuccess() {
+		return "", fmt.Errorf("failed to obtain OAuth2 access token from %s: returned status code %d: %s", ts.conf.TokenURL, res.StatusCode(), res.Body())
+	}
+
+	var tokenRes oauth2TokenResponse
+	if err := json.Unmarshal(res.Body(), &tokenRes); err != nil {
+		return "", fmt.Errorf("failed to parse OAuth2 token response from %s: %s", ts.conf.TokenURL, err)
+	}
+	if tokenRes.AccessToken == "" {
+		return "", fmt.Errorf("OAuth2 token response from %s did not contain an access_token", ts.conf.TokenURL)
+	}
+
+	expiresIn := oauth2DefaultExpiry
+	if tokenRes.ExpiresIn > 0 {
+		expiresIn = time.Duration(tokenRes.ExpiresIn) * time.Second
+	}
+	ts.token = tokenRes.AccessToken
+	ts.expiry = ts.now().Add(expiresIn)
+	tflog.Info(ctx, fmt.Sprintf("obtained OAuth2 access token from %s (expires in %s)", ts.conf.TokenURL, expiresIn))
+	return ts.token, nil
+}
+
+// AddOAuth2Auth configures the client to authenticate every request with a bearer token
+// from the token source. A 401 response invalidates the token that was sent, and the
+// request is retried once with a freshly fetched token.
+func AddOAuth2Auth(rc *resty.Client, ts *OAuth2TokenSource) {
+	rc.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		token, err := ts.Token(r.Context())
+		if err != nil {
+			return err
+		}
+		r.SetHeader("Authorization", fmt.Sprintf("Bearer %s", token))
+		return nil
+	})
+	rc.AddRetryCondition(func(r *resty.Response, err error) bool {
+		if err != nil || r == nil || r.StatusCode() != http.StatusUnauthorized {
+			return false
+		}
+		ts.Invalidate(strings.TrimPrefix(r.Request.Header.Get("Authorization"), "Bearer "))
+		return r.Request.Attempt <= 1
+	})
+}
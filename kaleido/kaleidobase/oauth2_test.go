@@ -0,0 +1,218 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleidobase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOIDC struct {
+	t         *testing.T
+	server    *httptest.Server
+	lock      sync.Mutex
+	issued    int
+	expiresIn int64
+	status    int
+}
+
+func startMockOIDC(t *testing.T) *mockOIDC {
+	m := &mockOIDC{t: t, expiresIn: 3600, status: 200}
+	m.server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.NoError(t, req.ParseForm())
+		assert.Equal(t, "client_credentials", req.PostForm.Get("grant_type"))
+		assert.Equal(t, "scope1 scope2", req.PostForm.Get("scope"))
+		clientID, clientSecret, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client1", clientID)
+		assert.Equal(t, "secret1", clientSecret)
+
+		if m.status != 200 {
+			res.WriteHeader(m.status)
+			_, _ = res.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		m.issued++
+		res.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(res).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token%d", m.issued),
+			"token_type":   "Bearer",
+			"expires_in":   m.expiresIn,
+		})
+	}))
+	return m
+}
+
+func (m *mockOIDC) tokensIssued() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.issued
+}
+
+func testOAuth2Config(m *mockOIDC) OAuth2Config {
+	return OAuth2Config{
+		TokenURL:     m.server.URL + "/token",
+		ClientID:     "client1",
+		ClientSecret: "secret1",
+		Scopes:       []string{"scope1", "scope2"},
+	}
+}
+
+func TestOAuth2TokenCachedAndRefreshedBeforeExpiry(t *testing.T) {
+	m := startMockOIDC(t)
+	defer m.server.Close()
+
+	now := time.Now()
+	ts := NewOAuth2TokenSource(testOAuth2Config(m), http.DefaultTransport)
+	ts.now = func() time.Time { return now }
+
+	token, err := ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token1", token)
+
+	// Cached while comfortably inside the expiry
+	now = now.Add(30 * time.Minute)
+	token, err = ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token1", token)
+	assert.Equal(t, 1, m.tokensIssued())
+
+	// Refreshed once we are inside the leeway before expiry
+	now = now.Add(30*time.Minute - oauth2ExpiryLeeway/2)
+	token, err = ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token2", token)
+	assert.Equal(t, 2, m.tokensIssued())
+}
+
+func TestOAuth2InvalidateOnlyDropsRejectedToken(t *testing.T) {
+	m := startMockOIDC(t)
+	defer m.server.Close()
+
+	ts := NewOAuth2TokenSource(testOAuth2Config(m), http.DefaultTransport)
+	token, err := ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token1", token)
+
+	ts.Invalidate("some-older-token")
+	token, err = ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token1", token)
+
+	ts.Invalidate("token1")
+	token, err = ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token2", token)
+}
+
+func TestOAuth2TokenEndpointError(t *testing.T) {
+	m := startMockOIDC(t)
+	defer m.server.Close()
+	m.status = 401
+
+	ts := NewOAuth2TokenSource(testOAuth2Config(m), http.DefaultTransport)
+	_, err := ts.Token(context.Background())
+	assert.Regexp(t, "failed to obtain OAuth2 access token.*401.*invalid_client", err)
+}
+
+func TestOAuth2MissingClientCredentials(t *testing.T) {
+	ts := NewOAuth2TokenSource(OAuth2Config{TokenURL: "http://localhost/token"}, http.DefaultTransport)
+	_, err := ts.Token(context.Background())
+	assert.Regexp(t, "platform_oauth2_client_id and platform_oauth2_client_secret must be set", err)
+}
+
+func TestOAuth2PlatformClientSharesTokenAndRefreshesOn401(t *testing.T) {
+	m := startMockOIDC(t)
+	defer m.server.Close()
+
+	var lock sync.Mutex
+	revoked := map[string]bool{}
+	seen := []string{}
+	platform := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		auth := req.Header.Get("Authorization")
+		seen = append(seen, auth)
+		if revoked[auth] {
+			res.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer platform.Close()
+
+	scopes := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("scope1"),
+		types.StringValue("scope2"),
+	})
+	pd := NewProviderData(context.Background(), &ProviderModel{
+		PlatformAPI:                types.StringValue(platform.URL),
+		PlatformOAuth2TokenURL:     types.StringValue(m.server.URL + "/token"),
+		PlatformOAuth2ClientID:     types.StringValue("client1"),
+		PlatformOAuth2ClientSecret: types.StringValue("secret1"),
+		PlatformOAuth2Scopes:       scopes,
+	})
+
+	for i := 0; i < 3; i++ {
+		res, err := pd.Platform.R().Get("/api/v1/self/identity")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode())
+	}
+	assert.Equal(t, 1, m.tokensIssued())
+
+	// The platform revokes the token before its expiry, so the next request is
+	// rejected and must be retried once with a new token
+	lock.Lock()
+	revoked["Bearer token1"] = true
+	lock.Unlock()
+	res, err := pd.Platform.R().Get("/api/v1/self/identity")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, 2, m.tokensIssued())
+
+	// A token rejected straight after being issued is only retried once
+	lock.Lock()
+	revoked["Bearer token2"] = true
+	revoked["Bearer token3"] = true
+	lock.Unlock()
+	res, err = pd.Platform.R().Get("/api/v1/self/identity")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode())
+	assert.Equal(t, 3, m.tokensIssued())
+
+	assert.Equal(t, []string{
+		"Bearer token1",
+		"Bearer token1",
+		"Bearer token1",
+		"Bearer token1",
+		"Bearer token2",
+		"Bearer token2",
+		"Bearer token3",
+	}, seen)
+}
@@ -16,20 +16,33 @@ package kaleidobase
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type kaleidoProvider struct {
 	version     string
 	resources   []func() resource.Resource
 	datasources []func() datasource.DataSource
+	ephemerals  []func() ephemeral.EphemeralResource
+	functions   []func() function.Function
+	actions     []func() action.Action
+	lists       []func() list.ListResource
 }
 
 var (
-	_ provider.Provider = &kaleidoProvider{}
+	_ provider.Provider                       = &kaleidoProvider{}
+	_ provider.ProviderWithEphemeralResources = &kaleidoProvider{}
+	_ provider.ProviderWithFunctions          = &kaleidoProvider{}
+	_ provider.ProviderWithActions            = &kaleidoProvider{}
+	_ provider.ProviderWithListResources      = &kaleidoProvider{}
 )
 
 // Metadata returns the provider type name.
@@ -68,6 +81,52 @@ func (p *kaleidoProvider) Schema(ctx context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Description: "For resources prefixed with `platform_`",
 			},
+			"platform_oauth2_token_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "For resources prefixed with `platform_`. Token endpoint of an OAuth2 / OIDC provider, used to obtain access tokens with the client credentials grant. Tokens are cached, and refreshed automatically before they expire.",
+			},
+			"platform_oauth2_client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "For resources prefixed with `platform_`. Client ID for the OAuth2 client credentials grant",
+			},
+			"platform_oauth2_client_secret": schema.StringAttribute{
+				Sensitive:   true,
+				Optional:    true,
+				Description: "For resources prefixed with `platform_`. Client secret for the OAuth2 client credentials grant",
+			},
+			"platform_oauth2_scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "For resources prefixed with `platform_`. Scopes to request for the OAuth2 client credentials grant",
+			},
+			"retry_initial_delay": schema.StringAttribute{
+				Optional:    true,
+				Description: "Delay before the first retry when polling a long-running operation, such as waiting for a resource to become ready. A duration such as `5s`. Defaults to a value suited to each resource",
+			},
+			"retry_maximum_delay": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum delay between retries when polling a long-running operation. A duration such as `30s`. Defaults to a value suited to each resource",
+			},
+			"retry_backoff_factor": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Factor the delay between retries is multiplied by after each attempt, up to `retry_maximum_delay`. Must be 1 or more",
+			},
+			"retry_jitter": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Fraction of each delay, between 0 and 1, to add at random so that concurrent operations do not retry in lockstep. Defaults to 0",
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of attempts when polling a long-running operation. Defaults to 0, which keeps retrying until the operation completes or its timeout expires",
+			},
+			"platform_http_retry_max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "For resources prefixed with `platform_`. Maximum number of times to retry a request that is rate limited, or that fails with a 502, 503 or 504 status or a dropped connection. Only GET, PUT and DELETE requests, and POST requests that carry an idempotency key, are retried on a failure. Defaults to 5",
+			},
+			"platform_http_retry_max_delay": schema.StringAttribute{
+				Optional:    true,
+				Description: "For resources prefixed with `platform_`. Maximum delay before retrying a request, including any delay requested by a `Retry-After` header. A duration such as `30s`. Defaults to `30s`",
+			},
 		},
 	}
 }
@@ -75,10 +134,20 @@ func (p *kaleidoProvider) Schema(ctx context.Context, _ provider.SchemaRequest,
 func (p *kaleidoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data ProviderModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	retryOverrides := data.ParseRetryOverrides(&resp.Diagnostics)
+	httpRetry := data.ParseHTTPRetry(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	pd := NewProviderData(ctx, &data)
+	pd.RetryOverrides = retryOverrides
+	httpRetry.ApplyTo(pd.Platform)
 	resp.DataSourceData = pd
 	resp.ResourceData = pd
+	resp.EphemeralResourceData = pd
+	resp.ActionData = pd
+	resp.ListResourceData = pd
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -91,10 +160,34 @@ func (p *kaleidoProvider) Resources(_ context.Context) []func() resource.Resourc
 	return p.resources
 }
 
-func New(version string, resources []func() resource.Resource, datasources []func() datasource.DataSource) provider.Provider {
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *kaleidoProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return p.ephemerals
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *kaleidoProvider) Functions(_ context.Context) []func() function.Function {
+	return p.functions
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *kaleidoProvider) Actions(_ context.Context) []func() action.Action {
+	return p.actions
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *kaleidoProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return p.lists
+}
+
+func New(version string, resources []func() resource.Resource, datasources []func() datasource.DataSource, ephemerals []func() ephemeral.EphemeralResource, functions []func() function.Function, actions []func() action.Action, lists []func() list.ListResource) provider.Provider {
 	return &kaleidoProvider{
 		version:     version,
 		resources:   resources,
 		datasources: datasources,
+		ephemerals:  ephemerals,
+		functions:   functions,
+		actions:     actions,
+		lists:       lists,
 	}
 }
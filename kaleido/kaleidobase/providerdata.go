@@ -17,15 +17,15 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"math/rand/v2"
 	"net"
 	"net/http"
 	"os"
-	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	kaleido "github.com/kaleido-io/kaleido-sdk-go/kaleido"
@@ -34,8 +34,15 @@ import (
 const version = "v1.2.0"
 
 type ProviderData struct {
-	BaaS     *kaleido.KaleidoClient
-	Platform *resty.Client
+	BaaS           *kaleido.KaleidoClient
+	Platform       *resty.Client
+	RetryOverrides RetryOverrides
+}
+
+// Retry returns the retry policy for an operation, starting from the defaults for the resource
+// and applying any retry settings from the provider configuration.
+func (pd *ProviderData) Retry(defaults CustomRetry) *CustomRetry {
+	return pd.RetryOverrides.Apply(defaults)
 }
 
 type ProviderModel struct {
@@ -45,6 +52,61 @@ type ProviderModel struct {
 	PlatformUsername    types.String `tfsdk:"platform_username"`
 	PlatformPassword    types.String `tfsdk:"platform_password"`
 	PlatformBearerToken types.String `tfsdk:"platform_bearer_token"`
+
+	PlatformOAuth2TokenURL     types.String `tfsdk:"platform_oauth2_token_url"`
+	PlatformOAuth2ClientID     types.String `tfsdk:"platform_oauth2_client_id"`
+	PlatformOAuth2ClientSecret types.String `tfsdk:"platform_oauth2_client_secret"`
+	PlatformOAuth2Scopes       types.List   `tfsdk:"platform_oauth2_scopes"`
+
+	RetryInitialDelay  types.String  `tfsdk:"retry_initial_delay"`
+	RetryMaximumDelay  types.String  `tfsdk:"retry_maximum_delay"`
+	RetryBackoffFactor types.Float64 `tfsdk:"retry_backoff_factor"`
+	RetryJitter        types.Float64 `tfsdk:"retry_jitter"`
+	RetryMaxAttempts   types.Int64   `tfsdk:"retry_max_attempts"`
+
+	PlatformHTTPRetryMaxRetries types.Int64  `tfsdk:"platform_http_retry_max_retries"`
+	PlatformHTTPRetryMaxDelay   types.String `tfsdk:"platform_http_retry_max_delay"`
+}
+
+func parseRetryDuration(name string, v types.String, diagnostics *diag.Diagnostics) *time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d <= 0 {
+		diagnostics.AddAttributeError(path.Root(name), "Invalid retry delay", fmt.Sprintf("%s must be a positive duration such as '5s' or '1m', got: '%s'", name, v.ValueString()))
+		return nil
+	}
+	return &d
+}
+
+// ParseRetryOverrides validates the retry_ settings in the provider configuration
+func (conf *ProviderModel) ParseRetryOverrides(diagnostics *diag.Diagnostics) RetryOverrides {
+	var o RetryOverrides
+	o.InitialDelay = parseRetryDuration("retry_initial_delay", conf.RetryInitialDelay, diagnostics)
+	o.MaximumDelay = parseRetryDuration("retry_maximum_delay", conf.RetryMaximumDelay, diagnostics)
+	if !conf.RetryBackoffFactor.IsNull() && !conf.RetryBackoffFactor.IsUnknown() {
+		factor := conf.RetryBackoffFactor.ValueFloat64()
+		if factor < 1 {
+			diagnostics.AddAttributeError(path.Root("retry_backoff_factor"), "Invalid retry backoff factor", fmt.Sprintf("retry_backoff_factor must be 1 or more, got: %v", factor))
+		}
+		o.Factor = &factor
+	}
+	if !conf.RetryJitter.IsNull() && !conf.RetryJitter.IsUnknown() {
+		jitter := conf.RetryJitter.ValueFloat64()
+		if jitter < 0 || jitter > 1 {
+			diagnostics.AddAttributeError(path.Root("retry_jitter"), "Invalid retry jitter", fmt.Sprintf("retry_jitter must be between 0 and 1, got: %v", jitter))
+		}
+		o.Jitter = &jitter
+	}
+	if !conf.RetryMaxAttempts.IsNull() && !conf.RetryMaxAttempts.IsUnknown() {
+		maxAttempts := int(conf.RetryMaxAttempts.ValueInt64())
+		if maxAttempts < 0 {
+			diagnostics.AddAttributeError(path.Root("retry_max_attempts"), "Invalid retry max attempts", fmt.Sprintf("retry_max_attempts must be 0 or more, got: %d", maxAttempts))
+		}
+		o.MaxAttempts = &maxAttempts
+	}
+	return o
 }
 
 func ConfigureProviderData(providerData any, diagnostics *diag.Diagnostics) *ProviderData {
@@ -91,11 +153,23 @@ func NewProviderData(logCtx context.Context, conf *ProviderModel) *ProviderData
 	platformUsername := conf.PlatformUsername.ValueString()
 	platformPassword := conf.PlatformPassword.ValueString()
 	platformBearerToken := conf.PlatformBearerToken.ValueString()
+	oauth2Conf := OAuth2Config{
+		TokenURL:     conf.PlatformOAuth2TokenURL.ValueString(),
+		ClientID:     conf.PlatformOAuth2ClientID.ValueString(),
+		ClientSecret: conf.PlatformOAuth2ClientSecret.ValueString(),
+	}
+	if !conf.PlatformOAuth2Scopes.IsNull() && !conf.PlatformOAuth2Scopes.IsUnknown() {
+		_ = conf.PlatformOAuth2Scopes.ElementsAs(logCtx, &oauth2Conf.Scopes, false)
+	}
 
-	if platformUsername == "" && platformPassword == "" && platformBearerToken == "" {
+	if platformUsername == "" && platformPassword == "" && platformBearerToken == "" && oauth2Conf.TokenURL == "" {
 		platformUsername = os.Getenv("KALEIDO_PLATFORM_USERNAME")
 		platformPassword = os.Getenv("KALEIDO_PLATFORM_PASSWORD")
 		platformBearerToken = os.Getenv("KALEIDO_PLATFORM_BEARER_TOKEN")
+		oauth2Conf.TokenURL = os.Getenv("KALEIDO_PLATFORM_OAUTH2_TOKEN_URL")
+		oauth2Conf.ClientID = os.Getenv("KALEIDO_PLATFORM_OAUTH2_CLIENT_ID")
+		oauth2Conf.ClientSecret = os.Getenv("KALEIDO_PLATFORM_OAUTH2_CLIENT_SECRET")
+		oauth2Conf.Scopes = strings.Fields(os.Getenv("KALEIDO_PLATFORM_OAUTH2_SCOPES"))
 	}
 
 	// mostly the default settings, barring less conns to avoid concurrency limits w/in the Platform
@@ -116,31 +190,14 @@ func NewProviderData(logCtx context.Context, conf *ProviderModel) *ProviderData
 	platform := resty.New().
 		SetTransport(platformHttp).
 		SetHeader("User-Agent", fmt.Sprintf("Terraform / %s (Platform)", version)).
-		AddRetryCondition(func(r *resty.Response, err error) bool {
-			if err != nil {
-				return false
-			}
-			if r.StatusCode() == http.StatusTooManyRequests {
-				return true
-			}
-			return false
-		}).
-		SetRetryCount(5).
-		SetRetryAfter(func(c *resty.Client, r *resty.Response) (time.Duration, error) {
-			tflog.Debug(logCtx, fmt.Sprintf("retryAfter: %s", r.Header().Get("Retry-After")))
-			retryAfter, err := strconv.ParseFloat(r.Header().Get("Retry-After"), 64) // decimal seconds
-			if err != nil {
-				retryAfter = 1.0
-			}
-			// Uniform [0, 5s) jitter to de-sync up to 5 concurrent connections retrying up to 5 times.
-			// rand.NormFloat64 was wrong here: ~50% of draws are negative, producing a negative final
-			// duration → time.Sleep returns instantly → thundering herd back into the 429.
-			jitter := time.Duration(rand.Float64() * float64(5*time.Second))
-			return time.Duration(retryAfter*float64(time.Second)) + jitter, nil
-		}).
+		AddRetryCondition(platformRetryCondition).
+		SetRetryAfter(platformRetryAfter(logCtx)).
 		SetBaseURL(platformAPI)
+	DefaultHTTPRetry.ApplyTo(platform)
 	if platformUsername != "" && platformPassword != "" {
 		platform = platform.SetBasicAuth(platformUsername, platformPassword)
+	} else if oauth2Conf.TokenURL != "" {
+		AddOAuth2Auth(platform, NewOAuth2TokenSource(oauth2Conf, platformHttp))
 	} else if platformBearerToken != "" {
 		platform = platform.SetHeader("Authorization", fmt.Sprintf("Bearer %s", platformBearerToken))
 	}
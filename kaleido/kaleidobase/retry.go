@@ -16,6 +16,7 @@ package kaleidobase
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,14 +27,57 @@ type CustomRetry struct {
 	InitialDelay time.Duration
 	MaximumDelay time.Duration
 	Factor       float64
+	// Jitter randomizes each delay by up to this fraction of it, so that concurrent
+	// operations polling the same API do not retry in lockstep
+	Jitter float64
+	// MaxAttempts limits the number of attempts. Zero means retry until the context is done
+	MaxAttempts int
 }
 
-var Retry = &CustomRetry{
+// DefaultRetry is the policy for operations on resources that do not have their own defaults
+var DefaultRetry = CustomRetry{
 	InitialDelay: 5 * time.Second,
 	MaximumDelay: 30 * time.Second,
 	Factor:       2.0,
 }
 
+// RetryOverrides are the retry settings from the provider configuration. Each one that
+// is set replaces the corresponding setting in the defaults of a resource.
+type RetryOverrides struct {
+	InitialDelay *time.Duration
+	MaximumDelay *time.Duration
+	Factor       *float64
+	Jitter       *float64
+	MaxAttempts  *int
+}
+
+func (o *RetryOverrides) Apply(defaults CustomRetry) *CustomRetry {
+	r := defaults
+	if o.InitialDelay != nil {
+		r.InitialDelay = *o.InitialDelay
+	}
+	if o.MaximumDelay != nil {
+		r.MaximumDelay = *o.MaximumDelay
+	}
+	if o.Factor != nil {
+		r.Factor = *o.Factor
+	}
+	if o.Jitter != nil {
+		r.Jitter = *o.Jitter
+	}
+	if o.MaxAttempts != nil {
+		r.MaxAttempts = *o.MaxAttempts
+	}
+	return &r
+}
+
+func (r *CustomRetry) jittered(delay time.Duration) time.Duration {
+	if r.Jitter <= 0 {
+		return delay
+	}
+	return delay + time.Duration(rand.Float64()*r.Jitter*float64(delay))
+}
+
 // Simple retry handler
 func (r *CustomRetry) Do(ctx context.Context, logDescription string, f func(attempt int) (retry bool, err error)) error {
 	attempt := 0
@@ -48,6 +92,9 @@ func (r *CustomRetry) Do(ctx context.Context, logDescription string, f func(atte
 		if !retry || err == nil {
 			return err
 		}
+		if r.MaxAttempts > 0 && attempt >= r.MaxAttempts {
+			return fmt.Errorf("gave up after %d attempts (last error: %s)", attempt, err)
+		}
 
 		// Check the context isn't canceled
 		select {
@@ -60,19 +107,20 @@ func (r *CustomRetry) Do(ctx context.Context, logDescription string, f func(atte
 		if delay > r.MaximumDelay {
 			delay = r.MaximumDelay
 		}
+		sleep := r.jittered(delay)
 		if deadline, dok := ctx.Deadline(); dok {
 			timeleft := time.Until(deadline)
 			if timeleft <= 0 {
 				return fmt.Errorf("context deadline exceeded (last error: %s)", err)
 			}
-			if timeleft < delay {
-				delay = timeleft
+			if timeleft < sleep {
+				sleep = timeleft
 			}
 		}
 
 		// Sleep, but bail early if the context is canceled mid-wait
 		// (otherwise terraform shutdown can hang up to MaximumDelay).
-		timer := time.NewTimer(delay)
+		timer := time.NewTimer(sleep)
 		select {
 		case <-ctx.Done():
 			timer.Stop()
@@ -0,0 +1,112 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kaleidobase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryOverridesApply(t *testing.T) {
+	defaults := CustomRetry{
+		InitialDelay: 10 * time.Second,
+		MaximumDelay: 60 * time.Second,
+		Factor:       2.0,
+	}
+
+	pd := &ProviderData{}
+	assert.Equal(t, defaults, *pd.Retry(defaults))
+
+	initialDelay := 1 * time.Second
+	maxAttempts := 3
+	pd.RetryOverrides = RetryOverrides{
+		InitialDelay: &initialDelay,
+		MaxAttempts:  &maxAttempts,
+	}
+	assert.Equal(t, CustomRetry{
+		InitialDelay: 1 * time.Second,
+		MaximumDelay: 60 * time.Second,
+		Factor:       2.0,
+		MaxAttempts:  3,
+	}, *pd.Retry(defaults))
+
+	// The defaults passed in are not modified
+	assert.Equal(t, 10*time.Second, defaults.InitialDelay)
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	r := &CustomRetry{
+		InitialDelay: time.Millisecond,
+		MaximumDelay: time.Millisecond,
+		Factor:       2.0,
+		MaxAttempts:  3,
+	}
+	attempts := 0
+	err := r.Do(context.Background(), "test", func(attempt int) (retry bool, err error) {
+		attempts = attempt
+		return true, fmt.Errorf("pop")
+	})
+	assert.Regexp(t, "gave up after 3 attempts.*pop", err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryJitterBounded(t *testing.T) {
+	r := &CustomRetry{Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		d := r.jittered(time.Second)
+		assert.GreaterOrEqual(t, d, time.Second)
+		assert.Less(t, d, 1500*time.Millisecond)
+	}
+	r.Jitter = 0
+	assert.Equal(t, time.Second, r.jittered(time.Second))
+}
+
+func TestParseRetryOverrides(t *testing.T) {
+	var diags diag.Diagnostics
+	conf := &ProviderModel{
+		RetryInitialDelay:  types.StringValue("250ms"),
+		RetryMaximumDelay:  types.StringNull(),
+		RetryBackoffFactor: types.Float64Value(1.5),
+		RetryJitter:        types.Float64Value(0.2),
+		RetryMaxAttempts:   types.Int64Value(10),
+	}
+	o := conf.ParseRetryOverrides(&diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, 250*time.Millisecond, *o.InitialDelay)
+	assert.Nil(t, o.MaximumDelay)
+	assert.Equal(t, 1.5, *o.Factor)
+	assert.Equal(t, 0.2, *o.Jitter)
+	assert.Equal(t, 10, *o.MaxAttempts)
+}
+
+func TestParseRetryOverridesInvalid(t *testing.T) {
+	var diags diag.Diagnostics
+	conf := &ProviderModel{
+		RetryInitialDelay:  types.StringValue("soon"),
+		RetryMaximumDelay:  types.StringValue("-1s"),
+		RetryBackoffFactor: types.Float64Value(0.5),
+		RetryJitter:        types.Float64Value(2),
+		RetryMaxAttempts:   types.Int64Value(-1),
+	}
+	_ = conf.ParseRetryOverrides(&diags)
+	assert.Equal(t, 5, diags.ErrorsCount())
+	assert.Regexp(t, "retry_initial_delay must be a positive duration.*soon", diags.Errors()[0].Detail())
+}
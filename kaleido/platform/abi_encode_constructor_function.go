@@ -0,0 +1,77 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hyperledger/firefly-signer/pkg/abi"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+)
+
+func ABIEncodeConstructorFunctionFactory() function.Function {
+	return &abi_encode_constructorFunction{}
+}
+
+type abi_encode_constructorFunction struct{}
+
+func (f *abi_encode_constructorFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "abi_encode_constructor"
+}
+
+func (f *abi_encode_constructorFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "ABI encode the constructor parameters of a contract",
+		Description: "Encodes the parameters of the constructor in the given ABI, returning them as `0x` prefixed hex. Append the result to the bytecode of the contract, such as the `bytecode` of a `kaleido_platform_cms_build`, to get the data of a deployment transaction.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "abi",
+				Description: "ABI of the contract as JSON, such as the `abi` of a `kaleido_platform_cms_build`",
+			},
+			function.StringParameter{
+				Name:        "params",
+				Description: "Constructor parameters as a JSON array or object, such as `jsonencode([\"name\", 18])`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *abi_encode_constructorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var abiJSON, params string
+	resp.Error = req.Arguments.Get(ctx, &abiJSON, &params)
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := parseABIJSON(ctx, abiJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid ABI: %s", err))
+		return
+	}
+
+	// A contract without a constructor in its ABI has the default constructor, with no parameters
+	constructor := a.Constructor()
+	if constructor == nil {
+		constructor = &abi.Entry{Type: abi.Constructor}
+	}
+	encoded, err := constructor.Inputs.EncodeABIDataJSONCtx(ctx, []byte(params))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid constructor parameters: %s", err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, ethtypes.HexBytes0xPrefix(encoded).String())
+}
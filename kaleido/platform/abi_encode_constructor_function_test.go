@@ -0,0 +1,67 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testConstructorABI = `[
+	{
+		"type": "constructor",
+		"inputs": [
+			{"name": "owner", "type": "address"},
+			{"name": "supply", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "owner",
+		"inputs": [],
+		"outputs": [{"name": "", "type": "address"}]
+	}
+]`
+
+func TestABIEncodeConstructorFunction(t *testing.T) {
+	expected := types.StringValue("0x" +
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed" +
+		"00000000000000000000000000000000000000000000000000000000000003e8")
+
+	result, funcErr := testRunFunction(t, ABIEncodeConstructorFunctionFactory(), testConstructorABI, `["0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 1000]`)
+	assert.Nil(t, funcErr)
+	assert.Equal(t, expected, result)
+
+	result, funcErr = testRunFunction(t, ABIEncodeConstructorFunctionFactory(), testConstructorABI, `{"supply": "1000", "owner": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"}`)
+	assert.Nil(t, funcErr)
+	assert.Equal(t, expected, result)
+}
+
+func TestABIEncodeConstructorFunctionDefaultConstructor(t *testing.T) {
+	result, funcErr := testRunFunction(t, ABIEncodeConstructorFunctionFactory(), `[]`, `[]`)
+	assert.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue("0x"), result)
+}
+
+func TestABIEncodeConstructorFunctionInvalid(t *testing.T) {
+	_, funcErr := testRunFunction(t, ABIEncodeConstructorFunctionFactory(), `not json`, `[]`)
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "invalid ABI", funcErr.Text)
+
+	_, funcErr = testRunFunction(t, ABIEncodeConstructorFunctionFactory(), testConstructorABI, `["not an address", 1000]`)
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "invalid constructor parameters", funcErr.Text)
+}
@@ -0,0 +1,69 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func ABIFunctionSelectorFunctionFactory() function.Function {
+	return &abi_function_selectorFunction{}
+}
+
+type abi_function_selectorFunction struct{}
+
+func (f *abi_function_selectorFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "abi_function_selector"
+}
+
+func (f *abi_function_selectorFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the selector of a function in an ABI",
+		Description: "Returns the 4 byte selector of a function in the given ABI, as `0x` prefixed hex, such as `0xa9059cbb` for `transfer(address,uint256)`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "abi",
+				Description: "ABI of the contract as JSON, such as the `abi` of a `kaleido_platform_cms_build`",
+			},
+			function.StringParameter{
+				Name:        "method",
+				Description: "Function name, or signature such as `balanceOf(address)` where the function is overloaded",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *abi_function_selectorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var abiJSON, method string
+	resp.Error = req.Arguments.Get(ctx, &abiJSON, &method)
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := parseABIJSON(ctx, abiJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid ABI: %s", err))
+		return
+	}
+	entry, err := abiFunction(ctx, a, method)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, entry.FunctionSelectorBytes().String())
+}
@@ -0,0 +1,65 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSelectorABI = `[
+	{
+		"type": "function",
+		"name": "transfer",
+		"inputs": [
+			{"name": "to", "type": "address"},
+			{"name": "value", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "mint",
+		"inputs": [{"name": "value", "type": "uint256"}]
+	},
+	{
+		"type": "function",
+		"name": "mint",
+		"inputs": [
+			{"name": "to", "type": "address"},
+			{"name": "value", "type": "uint256"}
+		]
+	}
+]`
+
+func TestABIFunctionSelectorFunction(t *testing.T) {
+	result, funcErr := testRunFunction(t, ABIFunctionSelectorFunctionFactory(), testSelectorABI, "transfer")
+	assert.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue("0xa9059cbb"), result)
+
+	result, funcErr = testRunFunction(t, ABIFunctionSelectorFunctionFactory(), testSelectorABI, "mint(address,uint256)")
+	assert.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue("0x40c10f19"), result)
+}
+
+func TestABIFunctionSelectorFunctionInvalid(t *testing.T) {
+	_, funcErr := testRunFunction(t, ABIFunctionSelectorFunctionFactory(), testSelectorABI, "mint")
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "overloaded", funcErr.Text)
+
+	_, funcErr = testRunFunction(t, ABIFunctionSelectorFunctionFactory(), testSelectorABI, "burn")
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "not found", funcErr.Text)
+}
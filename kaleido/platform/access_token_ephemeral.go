@@ -0,0 +1,128 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+)
+
+// Terraform is asked to renew the token this long before it expires, so a provider configured
+// with the token never receives one that expires while its requests are in flight
+const accessTokenRenewLeeway = 30 * time.Second
+
+type AccessTokenEphemeralModel struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+func AccessTokenEphemeralResourceFactory() ephemeral.EphemeralResource {
+	return &access_tokenEphemeralResource{}
+}
+
+type access_tokenEphemeralResource struct {
+	commonEphemeralResource
+}
+
+func (r *access_tokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_access_token"
+}
+
+func (r *access_tokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtains a bearer token for an application with OAuth enabled, using the OAuth2 client credentials grant against the identity provider the application trusts. The token is never persisted in state or plan files. A run that is still using the token 30 seconds before it expires fails, rather than passing an expired token on to other providers.",
+		Attributes: map[string]schema.Attribute{
+			"token_url": &schema.StringAttribute{
+				Required:    true,
+				Description: "Token endpoint of the OAuth2 / OIDC provider",
+			},
+			"client_id": &schema.StringAttribute{
+				Required:    true,
+				Description: "Client ID for the OAuth2 client credentials grant",
+			},
+			"client_secret": &schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Client secret for the OAuth2 client credentials grant",
+			},
+			"scopes": &schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Scopes to request for the OAuth2 client credentials grant",
+			},
+			"access_token": &schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token to present in the `Authorization` header",
+			},
+			"expires_at": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry of the token reported by the token endpoint, formatted in RFC3339",
+			},
+		},
+	}
+}
+
+func (r *access_tokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conf := kaleidobase.OAuth2Config{
+		TokenURL:     data.TokenURL.ValueString(),
+		ClientID:     data.ClientID.ValueString(),
+		ClientSecret: data.ClientSecret.ValueString(),
+	}
+	if !data.Scopes.IsNull() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &conf.Scopes, false)...)
+	}
+	ts := kaleidobase.NewOAuth2TokenSource(conf, r.Platform.GetClient().Transport)
+	token, err := ts.Token(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to obtain access token", err.Error())
+		return
+	}
+
+	data.AccessToken = types.StringValue(token)
+	data.ExpiresAt = types.StringValue(ts.Expiry().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "expires_at", []byte(`"`+data.ExpiresAt.ValueString()+`"`))...)
+	resp.RenewAt = ts.Expiry().Add(-accessTokenRenewLeeway)
+}
+
+// Renew is called by Terraform when the token is about to expire while a provider configured with it
+// is still in use. A renewed token cannot be passed to that provider, so this fails the run rather
+// than let it use an expired token.
+func (r *access_tokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	b, diags := req.Private.GetKey(ctx, "expires_at")
+	resp.Diagnostics.Append(diags...)
+	expiresAt := "its expiry"
+	if len(b) > 2 {
+		expiresAt = string(b[1 : len(b)-1])
+	}
+	resp.Diagnostics.AddError("access token expiring",
+		fmt.Sprintf("The access token from kaleido_platform_access_token expires at %s, before Terraform finished using it. Request a longer token lifetime from the identity provider, or split the configuration so fewer resources depend on the token.", expiresAt))
+}
@@ -0,0 +1,76 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+)
+
+var accessTokenEphemeralStep1 = `
+ephemeral "kaleido_platform_access_token" "token1" {
+    token_url = "%s/oauth/token"
+    client_id = "client1"
+    client_secret = "secret1"
+    scopes = ["platform"]
+}
+
+provider "echo" {
+    data = ephemeral.kaleido_platform_access_token.token1
+}
+
+resource "echo" "token1" {}
+`
+
+func TestAccessTokenEphemeral(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer mp.server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_10_0)},
+		ProtoV6ProviderFactories: testAccProvidersWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(accessTokenEphemeralStep1, mp.server.URL),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token1", tfjsonpath.New("data").AtMapKey("access_token"), knownvalue.StringExact("token_for_client1")),
+					statecheck.ExpectKnownValue("echo.token1", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) postOAuth2Token(res http.ResponseWriter, req *http.Request) {
+	clientID, clientSecret, ok := req.BasicAuth()
+	assert.True(mp.t, ok)
+	assert.Equal(mp.t, "secret1", clientSecret)
+	assert.NoError(mp.t, req.ParseForm())
+	assert.Equal(mp.t, "client_credentials", req.PostForm.Get("grant_type"))
+	assert.Equal(mp.t, "platform", req.PostForm.Get("scope"))
+	mp.respond(res, map[string]interface{}{
+		"access_token": "token_for_" + clientID,
+		"token_type":   "Bearer",
+		"expires_in":   300,
+	}, 200)
+}
@@ -28,18 +28,18 @@ import (
 )
 
 type AccountResourceModel struct {
-	ID                                   types.String `tfsdk:"id"`
-	Name                                 types.String `tfsdk:"name"`
-	OIDCClientID                         types.String `tfsdk:"oidc_client_id"`
-	ValidationPolicy                     types.String `tfsdk:"validation_policy"`
-	FirstUserEmail                       types.String `tfsdk:"first_user_email"`
-	FirstUserSub                         types.String `tfsdk:"first_user_sub"`
-	Hostnames                            types.Map    `tfsdk:"hostnames"`
-	UserJITEnabled                       types.Bool   `tfsdk:"user_jit_enabled"`
-	UserJITDefaultGroup                  types.String `tfsdk:"user_jit_default_group"`
-	BootstrapApplicationName             types.String `tfsdk:"bootstrap_application_name"`
-	BootstrapApplicationOAuthJSON        types.String `tfsdk:"bootstrap_application_oauth_json"`
-	BootstrapApplicationValidationPolicy types.String `tfsdk:"bootstrap_application_validation_policy"`
+	ID                                   types.String  `tfsdk:"id"`
+	Name                                 types.String  `tfsdk:"name"`
+	OIDCClientID                         types.String  `tfsdk:"oidc_client_id"`
+	ValidationPolicy                     types.String  `tfsdk:"validation_policy"`
+	FirstUserEmail                       types.String  `tfsdk:"first_user_email"`
+	FirstUserSub                         types.String  `tfsdk:"first_user_sub"`
+	Hostnames                            types.Map     `tfsdk:"hostnames"`
+	UserJITEnabled                       types.Bool    `tfsdk:"user_jit_enabled"`
+	UserJITDefaultGroup                  types.String  `tfsdk:"user_jit_default_group"`
+	BootstrapApplicationName             types.String  `tfsdk:"bootstrap_application_name"`
+	BootstrapApplicationOAuthJSON        jsonStringVal `tfsdk:"bootstrap_application_oauth_json"`
+	BootstrapApplicationValidationPolicy types.String  `tfsdk:"bootstrap_application_validation_policy"`
 }
 
 type AccountAPIModel struct {
@@ -128,6 +128,7 @@ func (r *accountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Name of the bootstrap application for the account",
 			},
 			"bootstrap_application_oauth_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "OAuth configuration for the bootstrap application",
 			},
@@ -31,19 +31,19 @@ import (
 )
 
 type AMSAddressResourceModel struct {
-	Environment            types.String `tfsdk:"environment"`
-	Service                types.String `tfsdk:"service"`
-	Address                types.String `tfsdk:"address"`
-	DisplayName            types.String `tfsdk:"display_name"`
-	Description            types.String `tfsdk:"description"`
-	InfoJSON               types.String `tfsdk:"info_json"`
-	Contract               types.Bool   `tfsdk:"contract"`
-	ContractManagerService types.String `tfsdk:"contract_manager_service"`
-	ContractManagerBuild   types.String `tfsdk:"contract_manager_build"`
-	FireflyNamespace       types.String `tfsdk:"firefly_namespace"`
-	FireflyAPI             types.String `tfsdk:"firefly_api"`
-	Created                types.String `tfsdk:"created"`
-	Updated                types.String `tfsdk:"updated"`
+	Environment            types.String  `tfsdk:"environment"`
+	Service                types.String  `tfsdk:"service"`
+	Address                types.String  `tfsdk:"address"`
+	DisplayName            types.String  `tfsdk:"display_name"`
+	Description            types.String  `tfsdk:"description"`
+	InfoJSON               jsonStringVal `tfsdk:"info_json"`
+	Contract               types.Bool    `tfsdk:"contract"`
+	ContractManagerService types.String  `tfsdk:"contract_manager_service"`
+	ContractManagerBuild   types.String  `tfsdk:"contract_manager_build"`
+	FireflyNamespace       types.String  `tfsdk:"firefly_namespace"`
+	FireflyAPI             types.String  `tfsdk:"firefly_api"`
+	Created                types.String  `tfsdk:"created"`
+	Updated                types.String  `tfsdk:"updated"`
 }
 
 func AMSAddressResourceFactory() resource.Resource {
@@ -86,6 +86,7 @@ func (r *ams_addressResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Description of the address",
 			},
 			"info_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "Additional metadata as JSON string",
 			},
@@ -210,9 +211,9 @@ func (data *AMSAddressResourceModel) fromAPI(apiResponse map[string]interface{},
 			diagnostics.AddError("Error marshalling info", err.Error())
 			return
 		}
-		data.InfoJSON = types.StringValue(string(raw))
+		data.InfoJSON = newJSONString(string(raw))
 	} else {
-		data.InfoJSON = types.StringNull()
+		data.InfoJSON = jsonStringNull()
 	}
 
 	if val, ok := apiResponse["contract"]; ok && val != nil {
@@ -342,3 +343,7 @@ func (r *ams_addressResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	r.waitForRemoval(ctx, r.apiPathResource(&data), &resp.Diagnostics)
 }
+
+func (r *ams_addressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "address")
+}
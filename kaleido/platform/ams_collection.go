@@ -30,14 +30,14 @@ import (
 )
 
 type AMSCollectionResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Environment types.String `tfsdk:"environment"`
-	Service     types.String `tfsdk:"service"`
-	Name        types.String `tfsdk:"name"`
-	DisplayName types.String `tfsdk:"display_name"`
-	Description types.String `tfsdk:"description"`
-	InfoJSON    types.String `tfsdk:"info_json"`
-	LabelsJSON  types.String `tfsdk:"labels_json"`
+	ID          types.String  `tfsdk:"id"`
+	Environment types.String  `tfsdk:"environment"`
+	Service     types.String  `tfsdk:"service"`
+	Name        types.String  `tfsdk:"name"`
+	DisplayName types.String  `tfsdk:"display_name"`
+	Description types.String  `tfsdk:"description"`
+	InfoJSON    jsonStringVal `tfsdk:"info_json"`
+	LabelsJSON  jsonStringVal `tfsdk:"labels_json"`
 }
 
 type AMSCollectionAPIModel struct {
@@ -88,10 +88,12 @@ func (r *ams_collectionResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional: true,
 			},
 			"info_json": &schema.StringAttribute{
-				Optional: true,
+				CustomType: jsonStringType{},
+				Optional:   true,
 			},
 			"labels_json": &schema.StringAttribute{
-				Optional: true,
+				CustomType: jsonStringType{},
+				Optional:   true,
 			},
 		},
 	}
@@ -196,3 +198,7 @@ func (r *ams_collectionResource) Delete(ctx context.Context, req resource.Delete
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *ams_collectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
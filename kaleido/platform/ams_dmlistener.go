@@ -170,3 +170,7 @@ func (r *ams_dmlistenerResource) Delete(ctx context.Context, req resource.Delete
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *ams_dmlistenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
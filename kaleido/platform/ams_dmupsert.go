@@ -19,6 +19,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,9 +29,9 @@ import (
 )
 
 type AMSDMUpsertResourceModel struct {
-	Environment    types.String `tfsdk:"environment"`
-	Service        types.String `tfsdk:"service"`
-	BulkUpsertYAML types.String `tfsdk:"bulk_upsert_yaml"`
+	Environment    types.String  `tfsdk:"environment"`
+	Service        types.String  `tfsdk:"service"`
+	BulkUpsertYAML yamlStringVal `tfsdk:"bulk_upsert_yaml"`
 }
 
 func AMSDMUpsertResourceFactory() resource.Resource {
@@ -57,8 +58,9 @@ func (r *ams_dmupsertResource) Schema(_ context.Context, _ resource.SchemaReques
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"bulk_upsert_yaml": &schema.StringAttribute{
+				CustomType:  yamlStringType{},
 				Required:    true,
-				Description: "This is a bulk upsert input payload in YAML/JSON",
+				Description: "This is a bulk upsert input payload in YAML/JSON. Changes to comments, key order or quoting style alone do not re-submit the payload",
 			},
 		},
 	}
@@ -95,11 +97,15 @@ func (r *ams_dmupsertResource) Create(ctx context.Context, req resource.CreateRe
 func (r *ams_dmupsertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 
 	var data AMSDMUpsertResourceModel
+	var priorBulkUpsertYAML yamlStringVal
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("bulk_upsert_yaml"), &priorBulkUpsertYAML)...)
 
-	parsedYAML := data.toAPI(&resp.Diagnostics)
-	if parsedYAML != nil {
-		_, _ = r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), parsedYAML, nil, &resp.Diagnostics)
+	if !priorBulkUpsertYAML.SemanticallyEqual(data.BulkUpsertYAML) {
+		parsedYAML := data.toAPI(&resp.Diagnostics)
+		if parsedYAML != nil {
+			_, _ = r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), parsedYAML, nil, &resp.Diagnostics)
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
@@ -112,3 +118,7 @@ func (r *ams_dmupsertResource) Read(ctx context.Context, req resource.ReadReques
 func (r *ams_dmupsertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// no-op
 }
+
+func (r *ams_dmupsertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError("Import not supported", "Import is not supported for this resource, as a bulk data model upsert has no state on the server to import")
+}
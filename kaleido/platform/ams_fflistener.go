@@ -30,12 +30,12 @@ import (
 )
 
 type AMSFFListenerResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Environment types.String `tfsdk:"environment"`
-	Service     types.String `tfsdk:"service"`
-	Name        types.String `tfsdk:"name"`
-	Disabled    types.Bool   `tfsdk:"disabled"`
-	ConfigJSON  types.String `tfsdk:"config_json"`
+	ID          types.String  `tfsdk:"id"`
+	Environment types.String  `tfsdk:"environment"`
+	Service     types.String  `tfsdk:"service"`
+	Name        types.String  `tfsdk:"name"`
+	Disabled    types.Bool    `tfsdk:"disabled"`
+	ConfigJSON  jsonStringVal `tfsdk:"config_json"`
 }
 
 type AMSFFListenerAPIModel struct {
@@ -83,7 +83,8 @@ func (r *ams_fflistenerResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Default:  booldefault.StaticBool(false),
 			},
 			"config_json": &schema.StringAttribute{
-				Required: true,
+				CustomType: jsonStringType{},
+				Required:   true,
 			},
 		},
 	}
@@ -117,7 +118,7 @@ func (r *ams_fflistenerResource) Create(ctx context.Context, req resource.Create
 	var api AMSFFListenerAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
 	if ok {
-		ok, _ = r.apiRequest(ctx, http.MethodPut, r.apiPath(&data, data.Name.ValueString()), &api, &api, &resp.Diagnostics)
+		ok, _ = r.apiRequest(ctx, http.MethodPut, r.apiPath(&data, data.Name.ValueString()), &api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	}
 	if !ok {
 		return
@@ -137,7 +138,7 @@ func (r *ams_fflistenerResource) Update(ctx context.Context, req resource.Update
 	var api AMSFFListenerAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
 	if ok {
-		ok, _ = r.apiRequest(ctx, http.MethodPut, r.apiPath(&data, data.ID.ValueString()), &api, &api, &resp.Diagnostics)
+		ok, _ = r.apiRequest(ctx, http.MethodPut, r.apiPath(&data, data.ID.ValueString()), &api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	}
 	if !ok {
 		return
@@ -174,3 +175,7 @@ func (r *ams_fflistenerResource) Delete(ctx context.Context, req resource.Delete
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *ams_fflistenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -211,3 +211,7 @@ func (r *ams_policyResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *ams_policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -29,14 +29,14 @@ import (
 )
 
 type AMSTaskResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	Environment    types.String `tfsdk:"environment"`
-	Service        types.String `tfsdk:"service"`
-	TaskYAML       types.String `tfsdk:"task_yaml"` // this is propagated to a task version
-	AppliedVersion types.String `tfsdk:"applied_version"`
-	VariableSet    types.String `tfsdk:"variable_set"`
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	Description    types.String  `tfsdk:"description"`
+	Environment    types.String  `tfsdk:"environment"`
+	Service        types.String  `tfsdk:"service"`
+	TaskYAML       yamlStringVal `tfsdk:"task_yaml"` // this is propagated to a task version
+	AppliedVersion types.String  `tfsdk:"applied_version"`
+	VariableSet    types.String  `tfsdk:"variable_set"`
 }
 
 type AMSTaskAPIModel struct {
@@ -57,6 +57,9 @@ type ams_taskResource struct {
 	commonResource
 }
 
+// amsTaskIdentity identifies a task by the attributes of its import ID
+var amsTaskIdentity = resourceIdentity{"environment", "service", "id"}
+
 func (r *ams_taskResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_ams_task"
 }
@@ -83,8 +86,9 @@ func (r *ams_taskResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"task_yaml": &schema.StringAttribute{
+				CustomType:  yamlStringType{},
 				Required:    true,
-				Description: "This is the definition of the task - which will be put into a new version each time the task is updated. Name must be omitted from this YAML",
+				Description: "This is the definition of the task - which will be put into a new version each time the task YAML changes. Name must be omitted from this YAML. Changes to comments, key order or quoting style alone do not create a new version",
 			},
 			"applied_version": &schema.StringAttribute{
 				Computed: true,
@@ -98,6 +102,13 @@ func (r *ams_taskResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 
 func (api *AMSTaskAPIModel) toData(data *AMSTaskResourceModel) {
 	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	if api.Description != "" {
+		data.Description = types.StringValue(api.Description)
+	}
+	if api.VariableSet != "" {
+		data.VariableSet = types.StringValue(api.VariableSet)
+	}
 	data.AppliedVersion = types.StringValue(api.CurrentVersion)
 }
 
@@ -156,6 +167,7 @@ func (r *ams_taskResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	api.toData(&data)
+	amsTaskIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 }
@@ -163,8 +175,10 @@ func (r *ams_taskResource) Create(ctx context.Context, req resource.CreateReques
 func (r *ams_taskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 
 	var data AMSTaskResourceModel
+	var priorTaskYAML yamlStringVal
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("task_yaml"), &priorTaskYAML)...)
 
 	var api AMSTaskAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
@@ -173,8 +187,8 @@ func (r *ams_taskResource) Update(ctx context.Context, req resource.UpdateReques
 		// Task PATCH
 		ok, _ = r.apiRequest(ctx, http.MethodPatch, r.apiPath(&data, taskID), &api, &api, &resp.Diagnostics)
 	}
-	if ok {
-		// Task version POST
+	if ok && !priorTaskYAML.SemanticallyEqual(data.TaskYAML) {
+		// Task version POST - only if the task definition has actually changed
 		ok, _ = r.apiRequest(ctx, http.MethodPost, r.apiTaskVersionPath(&data, api.Name), data.TaskYAML.ValueString(), nil, &resp.Diagnostics, YAMLBody())
 	}
 	if !ok {
@@ -182,6 +196,7 @@ func (r *ams_taskResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	api.toData(&data)
+	amsTaskIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -201,6 +216,7 @@ func (r *ams_taskResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	api.toData(&data)
+	amsTaskIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -212,3 +228,11 @@ func (r *ams_taskResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *ams_taskResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = amsTaskIdentity.schema()
+}
+
+func (r *ams_taskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, amsTaskIdentity...)
+}
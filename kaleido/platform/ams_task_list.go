@@ -0,0 +1,76 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AMSTaskListModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Service     types.String `tfsdk:"service"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+}
+
+func AMSTaskListResourceFactory() list.ListResource {
+	return &ams_taskResource{}
+}
+
+func (r *ams_taskResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "List the tasks in an Asset Manager service, optionally filtered by name prefix.",
+		Attributes: map[string]listschema.Attribute{
+			"environment": listschema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": listschema.StringAttribute{
+				Required:    true,
+				Description: "Asset Manager Service ID",
+			},
+			"name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list tasks with a name starting with this prefix",
+			},
+		},
+	}
+}
+
+func (r *ams_taskResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config AMSTaskListModel
+	diagnostics := req.Config.Get(ctx, &config)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/tasks", config.Environment.ValueString(), config.Service.ValueString())
+	tasks, ok := listAll[AMSTaskAPIModel](ctx, r, collectionPath, url.Values{}, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	tasks = filterByNamePrefix(tasks, config.NamePrefix, func(v *AMSTaskAPIModel) string { return v.Name })
+
+	stream.Results = listResults(ctx, req, amsTaskIdentity, []types.String{config.Environment, config.Service}, tasks, func(api *AMSTaskAPIModel, data *AMSTaskResourceModel, diagnostics *diag.Diagnostics) {
+		api.toData(data)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAMSTaskList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.amsTasks["env1/service1/task1_id"] = &AMSTaskAPIModel{ID: "task1_id", Name: "erc20-indexer", Description: "Index ERC-20 transfers", CurrentVersion: "v2", VariableSet: "vars1"}
+	mp.amsTasks["env1/service1/task2_id"] = &AMSTaskAPIModel{ID: "task2_id", Name: "erc721-indexer", CurrentVersion: "v1"}
+	mp.amsTasks["env1/service1/task3_id"] = &AMSTaskAPIModel{ID: "task3_id", Name: "custom-task", CurrentVersion: "v1"}
+	mp.amsTasks["env1/service2/task4_id"] = &AMSTaskAPIModel{ID: "task4_id", Name: "erc20-indexer", CurrentVersion: "v1"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, AMSTaskListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"service":     tftypes.NewValue(tftypes.String, "service1"),
+		"name_prefix": tftypes.NewValue(tftypes.String, "erc"),
+	}, true, 0)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "erc20-indexer", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "id": "task1_id"}, testListResultAttrs(t, results[0].Identity, "environment", "service", "id"))
+	assert.Equal(t, map[string]string{
+		"environment":     "env1",
+		"service":         "service1",
+		"id":              "task1_id",
+		"name":            "erc20-indexer",
+		"description":     "Index ERC-20 transfers",
+		"variable_set":    "vars1",
+		"applied_version": "v2",
+	}, testListResultAttrs(t, results[0].Resource, "environment", "service", "id", "name", "description", "variable_set", "applied_version"))
+	assert.Equal(t, "erc721-indexer", results[1].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "id": "task2_id"}, testListResultAttrs(t, results[1].Identity, "environment", "service", "id"))
+	mp.checkClearCalls([]string{
+		"GET /endpoint/{env}/{service}/rest/api/v1/tasks",
+	})
+}
+
+func (mp *mockPlatform) listAMSTasks(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.amsTasks, mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/")
+}
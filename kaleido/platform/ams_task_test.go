@@ -64,6 +64,27 @@ resource "kaleido_platform_ams_task" "ams_task1" {
 }
 `
 
+// Same task definition as step 2, but with comments, different key order and quoting
+var ams_taskStep3 = `
+resource "kaleido_platform_ams_task" "ams_task1" {
+    environment = "env1"
+	service = "service1"
+	name = "ams_task1"
+	description = "shiny task that does stuff and more stuff"
+    task_yaml = <<EOT
+# steps run in order
+steps:
+  - things: 'stuff'
+    name: step1
+  - stuff: "other stuff" # second step
+    name: step2
+description: this version is super and great, note this is a different description
+EOT
+  variable_set = "my-variables"
+
+}
+`
+
 func TestAMSTask1(t *testing.T) {
 
 	mp, providerConfig := testSetup(t)
@@ -76,6 +97,10 @@ func TestAMSTask1(t *testing.T) {
 			"PATCH /endpoint/{env}/{service}/rest/api/v1/tasks/{task}", // then by ID
 			"POST /endpoint/{env}/{service}/rest/api/v1/tasks/{task}/versions",
 			"GET /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
+			"PATCH /endpoint/{env}/{service}/rest/api/v1/tasks/{task}", // reformatted YAML, so no new version
+			"GET /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
 			"DELETE /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
 			"GET /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
 		})
@@ -123,6 +148,28 @@ func TestAMSTask1(t *testing.T) {
 					},
 				),
 			},
+			{
+				Config: providerConfig + ams_taskStep3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ams_task1Resource, "applied_version"),
+					func(s *terraform.State) error {
+						// Versions are posted by task name - the reformatted YAML did not add a third
+						assert.Equal(t, 2, mp.amsTaskVersionPosts["env1/service1/ams_task1"])
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      ams_task1Resource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the task definition is posted as a version, and is not read back from the task
+				ImportStateVerifyIgnore: []string{"task_yaml"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[ams_task1Resource].Primary.Attributes["id"]
+					return fmt.Sprintf("env1/service1/%s", id), nil
+				},
+			},
 		},
 	})
 }
@@ -170,6 +217,7 @@ func (mp *mockPlatform) postAMSTaskVersion(res http.ResponseWriter, req *http.Re
 	var newObj map[string]interface{}
 	mp.getBody(req, &newObj)
 	mp.amsTaskVersions[mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/"+mux.Vars(req)["task"]] = newObj
+	mp.amsTaskVersionPosts[mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/"+mux.Vars(req)["task"]]++
 	mp.respond(res, &newObj, 200)
 }
 
@@ -30,13 +30,13 @@ import (
 )
 
 type AMSVariableSetResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Environment    types.String `tfsdk:"environment"`
-	Service        types.String `tfsdk:"service"`
-	Name           types.String `tfsdk:"name"`
-	Classification types.String `tfsdk:"classification"`
-	Description    types.String `tfsdk:"description"`
-	VariablesJSON  types.String `tfsdk:"variables_json"`
+	ID             types.String  `tfsdk:"id"`
+	Environment    types.String  `tfsdk:"environment"`
+	Service        types.String  `tfsdk:"service"`
+	Name           types.String  `tfsdk:"name"`
+	Classification types.String  `tfsdk:"classification"`
+	Description    types.String  `tfsdk:"description"`
+	VariablesJSON  jsonStringVal `tfsdk:"variables_json"`
 }
 
 type AMSVariableSetAPIModel struct {
@@ -87,8 +87,9 @@ func (r *ams_variablesetResource) Schema(_ context.Context, _ resource.SchemaReq
 				Optional: true,
 			},
 			"variables_json": &schema.StringAttribute{
-				Sensitive: true,
-				Required:  true,
+				CustomType: jsonStringType{},
+				Sensitive:  true,
+				Required:   true,
 			},
 		},
 	}
@@ -184,3 +185,7 @@ func (r *ams_variablesetResource) Delete(ctx context.Context, req resource.Delet
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *ams_variablesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -0,0 +1,269 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+)
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrConflict    = errors.New("conflict")
+	ErrRateLimited = errors.New("rate limited")
+	ErrValidation  = errors.New("validation failed")
+)
+
+// PlatformErrorBody is the JSON error envelope returned by the platform. The error is
+// typically prefixed with a code, such as "KA010203: invalid config", while newer APIs
+// return the code, message and the name of the offending field separately.
+type PlatformErrorBody struct {
+	Error     string `json:"error,omitempty"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Field     string `json:"field,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+var platformErrorCodePrefix = regexp.MustCompile(`(?s)^([A-Z]{2,3}[0-9]{4,6}):\s*(.*)$`)
+
+// APIError is returned for a request the platform responded to with a non-2xx status. Use
+// errors.Is with ErrNotFound, ErrConflict, ErrRateLimited or ErrValidation to check the kind.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       []byte
+	// PlatformError is the parsed error body, or nil if the body was not a JSON error envelope
+	PlatformError *PlatformErrorBody
+	// RequestID is the platform request ID from the error body or the X-Request-Id header
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s returned status code %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// Code is the platform error code, if there is one
+func (e *APIError) Code() string {
+	if e.PlatformError == nil {
+		return ""
+	}
+	if e.PlatformError.Code != "" {
+		return e.PlatformError.Code
+	}
+	if m := platformErrorCodePrefix.FindStringSubmatch(e.PlatformError.Error); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// Message is the human readable error from the platform without its code, or the raw
+// response body if it was not a JSON error envelope
+func (e *APIError) Message() string {
+	if e.PlatformError == nil {
+		return string(e.Body)
+	}
+	if e.PlatformError.Message != "" {
+		return e.PlatformError.Message
+	}
+	if m := platformErrorCodePrefix.FindStringSubmatch(e.PlatformError.Error); m != nil {
+		return m[2]
+	}
+	return e.PlatformError.Error
+}
+
+// Field is the request field the platform reported the error against, if any
+func (e *APIError) Field() string {
+	if e.PlatformError == nil {
+		return ""
+	}
+	return e.PlatformError.Field
+}
+
+func newAPIError(method, path string, statusCode int, header http.Header, body []byte) *APIError {
+	e := &APIError{
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		Body:       body,
+		RequestID:  header.Get("X-Request-Id"),
+	}
+	var platformError PlatformErrorBody
+	if err := json.Unmarshal(body, &platformError); err == nil && (platformError.Error != "" || platformError.Message != "") {
+		e.PlatformError = &platformError
+		if platformError.RequestID != "" {
+			e.RequestID = platformError.RequestID
+		}
+	}
+	return e
+}
+
+// multipartFormBody is a multipart/form-data request body. It is encoded in full before the
+// request is sent, so that the request can be retried.
+type multipartFormBody struct {
+	Fields map[string]string
+	Files  []multipartFile
+}
+
+type multipartFile struct {
+	FieldName string
+	FileName  string
+	Content   io.Reader
+}
+
+func (m *multipartFormBody) encode() ([]byte, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	names := make([]string, 0, len(m.Fields))
+	for name := range m.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := w.WriteField(name, m.Fields[name]); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, f := range m.Files {
+		fw, err := w.CreateFormFile(f.FieldName, f.FileName)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := io.Copy(fw, f.Content); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return b.Bytes(), w.FormDataContentType(), nil
+}
+
+// platformClient is the client for the platform REST API used by all platform resources and
+// data sources. It reports failures as Go errors rather than diagnostics, so it can be used
+// and tested without any Terraform types.
+type platformClient struct {
+	rest *resty.Client
+}
+
+func newPlatformClient(rest *resty.Client) *platformClient {
+	return &platformClient{rest: rest}
+}
+
+func encodeRequestBody(body interface{}, isYaml bool) (bodyBytes []byte, contentType string, err error) {
+	contentType = "application/json"
+	if isYaml {
+		contentType = "application/x-yaml"
+	}
+	switch tBody := body.(type) {
+	case nil:
+		return nil, contentType, nil
+	case []byte:
+		return tBody, contentType, nil
+	case string:
+		return []byte(tBody), contentType, nil
+	case *multipartFormBody:
+		return tBody.encode()
+	default:
+		if isYaml {
+			bodyBytes, err = yaml.Marshal(body)
+		} else {
+			bodyBytes, err = json.Marshal(body)
+		}
+		return bodyBytes, contentType, err
+	}
+}
+
+// Do sends a request to the platform, and unmarshals a successful JSON response into result
+// when it is non-nil. The status code is returned with any error, or -1 if no response was
+// received. A non-2xx response is returned as an *APIError.
+func (c *platformClient) Do(ctx context.Context, method, path string, body, result interface{}, options ...*APIRequestOption) (int, error) {
+	isYaml := false
+	reqCtx := ctx
+	for _, o := range options {
+		isYaml = isYaml || o.yamlBody
+		if o.idempotencyKey != "" {
+			reqCtx = kaleidobase.WithIdempotencyKey(ctx, o.idempotencyKey)
+		}
+	}
+
+	bodyBytes, contentType, err := encodeRequestBody(body, isYaml)
+	if err != nil {
+		return -1, fmt.Errorf("%s %s failed with error: %w", method, path, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("--> %s %s%s %s", method, c.rest.BaseURL, path, bodyBytes))
+
+	req := c.rest.R().
+		SetContext(reqCtx).
+		SetDoNotParseResponse(true).
+		SetHeader("Content-type", contentType)
+	if bodyBytes != nil {
+		req = req.SetBody(bodyBytes)
+	}
+	res, err := req.Execute(method, path)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("<-- %s %s%s [%s]", method, c.rest.BaseURL, path, err))
+		return -1, fmt.Errorf("%s %s failed with error: %w", method, path, err)
+	}
+	statusCode := res.StatusCode()
+	tflog.Debug(ctx, fmt.Sprintf("<-- %s %s%s [%d %s]", method, c.rest.BaseURL, path, statusCode, res.Status()))
+
+	var rawBytes []byte
+	if res.RawResponse != nil {
+		defer res.RawResponse.Body.Close()
+		rawBytes, err = io.ReadAll(res.RawBody())
+		if err != nil {
+			return statusCode, fmt.Errorf("%s %s failed with error: %w", method, path, err)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Response: %s", rawBytes))
+	}
+	if !res.IsSuccess() {
+		return statusCode, newAPIError(method, path, statusCode, res.Header(), rawBytes)
+	}
+	if result != nil {
+		if err := json.Unmarshal(rawBytes, &result); err != nil {
+			return statusCode, fmt.Errorf("%s %s failed with error: %w", method, path, err)
+		}
+	}
+	return statusCode, nil
+}
@@ -0,0 +1,174 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlatformClient(t *testing.T, handler http.HandlerFunc) (*platformClient, func()) {
+	server := httptest.NewServer(handler)
+	return newPlatformClient(resty.New().SetBaseURL(server.URL)), server.Close
+}
+
+func TestPlatformClientJSON(t *testing.T) {
+	c, done := newTestPlatformClient(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"name":"thing1"}`, string(body))
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusCreated)
+		_, _ = res.Write([]byte(`{"id":"id1","name":"thing1"}`))
+	})
+	defer done()
+
+	var result struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	status, err := c.Do(context.Background(), http.MethodPost, "/api/v1/things", map[string]string{"name": "thing1"}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "id1", result.ID)
+}
+
+func TestPlatformClientYAML(t *testing.T) {
+	c, done := newTestPlatformClient(t, func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "application/x-yaml", req.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "name: thing1\n", string(body))
+		res.WriteHeader(http.StatusNoContent)
+	})
+	defer done()
+
+	status, err := c.Do(context.Background(), http.MethodPut, "/api/v1/things", map[string]string{"name": "thing1"}, nil, YAMLBody())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+}
+
+func TestPlatformClientMultipart(t *testing.T) {
+	c, done := newTestPlatformClient(t, func(res http.ResponseWriter, req *http.Request) {
+		require.NoError(t, req.ParseMultipartForm(1<<20))
+		assert.Equal(t, "image/png", req.FormValue("type"))
+		file, header, err := req.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "icon.png", header.Filename)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "not really a png", string(content))
+		res.WriteHeader(http.StatusNoContent)
+	})
+	defer done()
+
+	_, err := c.Do(context.Background(), http.MethodPost, "/api/v1/assets/a1/icon", &multipartFormBody{
+		Fields: map[string]string{"type": "image/png"},
+		Files: []multipartFile{{
+			FieldName: "file",
+			FileName:  "icon.png",
+			Content:   strings.NewReader("not really a png"),
+		}},
+	}, nil)
+	require.NoError(t, err)
+}
+
+func TestPlatformClientTypedErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		kind   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnprocessableEntity, ErrValidation},
+	}
+	for _, tc := range tests {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			c, done := newTestPlatformClient(t, func(res http.ResponseWriter, req *http.Request) {
+				res.WriteHeader(tc.status)
+				_, _ = res.Write([]byte(`{"error":"KA010001: bad things"}`))
+			})
+			defer done()
+
+			status, err := c.Do(context.Background(), http.MethodGet, "/api/v1/things/t1", nil, nil)
+			assert.Equal(t, tc.status, status)
+			assert.ErrorIs(t, err, tc.kind)
+			for _, other := range []error{ErrNotFound, ErrConflict, ErrRateLimited, ErrValidation} {
+				if other != tc.kind {
+					assert.False(t, errors.Is(err, other))
+				}
+			}
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			require.NotNil(t, apiErr.PlatformError)
+			assert.Equal(t, "KA010001: bad things", apiErr.PlatformError.Error)
+			assert.Regexp(t, "GET /api/v1/things/t1 returned status code", err)
+		})
+	}
+}
+
+func TestPlatformClientNonJSONError(t *testing.T) {
+	c, done := newTestPlatformClient(t, func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusInternalServerError)
+		_, _ = res.Write([]byte(`<html>oops</html>`))
+	})
+	defer done()
+
+	_, err := c.Do(context.Background(), http.MethodGet, "/api/v1/things", nil, nil)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Nil(t, apiErr.PlatformError)
+	assert.Equal(t, "<html>oops</html>", string(apiErr.Body))
+}
+
+func TestPlatformClientConnectionError(t *testing.T) {
+	c, done := newTestPlatformClient(t, func(res http.ResponseWriter, req *http.Request) {})
+	done()
+
+	status, err := c.Do(context.Background(), http.MethodGet, "/api/v1/things", nil, nil)
+	assert.Equal(t, -1, status)
+	assert.Regexp(t, "GET /api/v1/things failed with error", err)
+	var apiErr *APIError
+	assert.False(t, errors.As(err, &apiErr))
+}
+
+func TestAPIErrorEnvelope(t *testing.T) {
+	apiErr := newAPIError(http.MethodPost, "/api/v1/things", 400, http.Header{"X-Request-Id": []string{"header-id"}},
+		[]byte(`{"code":"KA010001","message":"name is required","field":"name","requestId":"body-id"}`))
+	assert.Equal(t, "KA010001", apiErr.Code())
+	assert.Equal(t, "name is required", apiErr.Message())
+	assert.Equal(t, "name", apiErr.Field())
+	assert.Equal(t, "body-id", apiErr.RequestID)
+
+	apiErr = newAPIError(http.MethodPost, "/api/v1/things", 400, http.Header{"X-Request-Id": []string{"header-id"}},
+		[]byte(`{"error":"KA010002: invalid\nmulti-line error"}`))
+	assert.Equal(t, "KA010002", apiErr.Code())
+	assert.Equal(t, "invalid\nmulti-line error", apiErr.Message())
+	assert.Equal(t, "", apiErr.Field())
+	assert.Equal(t, "header-id", apiErr.RequestID)
+
+	apiErr = newAPIError(http.MethodPost, "/api/v1/things", 400, http.Header{}, []byte(`{"error":"no code here"}`))
+	assert.Equal(t, "", apiErr.Code())
+	assert.Equal(t, "no code here", apiErr.Message())
+}
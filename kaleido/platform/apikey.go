@@ -181,3 +181,7 @@ func (r *api_keyResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *api_keyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "application_id", "id")
+}
@@ -0,0 +1,150 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The API key outlives the Terraform run that minted it, as it is handed on to other systems, and
+// expires on its own unless revoke_on_close is set. Each plan and apply mints a new key, so the
+// default is kept short.
+const defaultEphemeralAPIKeyExpiry = 1 * time.Hour
+
+type APIKeyEphemeralModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	ExpiresIn     types.String `tfsdk:"expires_in"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	Secret        types.String `tfsdk:"secret"`
+	ExpiryDate    types.String `tfsdk:"expiry_date"`
+}
+
+// apiKeyEphemeralPrivate is kept in the private data of the ephemeral resource when revoke_on_close
+// is set, to delete the API key on close
+type apiKeyEphemeralPrivate struct {
+	ApplicationID string `json:"applicationId"`
+	ID            string `json:"id"`
+}
+
+func APIKeyEphemeralResourceFactory() ephemeral.EphemeralResource {
+	return &api_keyEphemeralResource{}
+}
+
+type api_keyEphemeralResource struct {
+	commonEphemeralResource
+}
+
+func (r *api_keyEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_api_key"
+}
+
+func (r *api_keyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a short-lived API key for an application. The key remains valid until it expires, so it can be passed on to other systems such as Kubernetes secrets or Vault, unless `revoke_on_close` is set. A new key is minted on every plan and apply. The secret is never persisted in state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": &schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the application to create the API key under. Note that the application's access, determines the capabilities of the API key.",
+			},
+			"name": &schema.StringAttribute{
+				Required:    true,
+				Description: "API Key Name",
+			},
+			"expires_in": &schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the API key is valid for, as a duration such as `30m`. Defaults to `1h`",
+			},
+			"revoke_on_close": &schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set to `true` to delete the API key as soon as Terraform has finished with it, at the end of each plan or apply. Only use this when the key is not stored by the resources it is passed to. Defaults to `false`, which leaves the key to expire after `expires_in`.",
+			},
+			"id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"secret": &schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API Key Value",
+			},
+			"expiry_date": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration date of the API key, formatted in RFC3339",
+			},
+		},
+	}
+}
+
+func (r *api_keyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data APIKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresIn := defaultEphemeralAPIKeyExpiry
+	if data.ExpiresIn.ValueString() != "" {
+		var err error
+		expiresIn, err = time.ParseDuration(data.ExpiresIn.ValueString())
+		if err != nil || expiresIn <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "invalid expires_in", fmt.Sprintf("'%s' is not a valid positive duration", data.ExpiresIn.ValueString()))
+			return
+		}
+	}
+
+	api := APIKeyAPIModel{
+		Name:       data.Name.ValueString(),
+		ExpiryDate: time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+	}
+	apiPath := fmt.Sprintf("/api/v1/applications/%s/api-keys", data.ApplicationID.ValueString())
+	if ok, _ := r.apiRequest(ctx, http.MethodPost, apiPath, api, &api, &resp.Diagnostics); !ok {
+		return
+	}
+
+	if data.RevokeOnClose.ValueBool() {
+		private, _ := json.Marshal(&apiKeyEphemeralPrivate{ApplicationID: data.ApplicationID.ValueString(), ID: api.ID})
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "api_key", private)...)
+	}
+
+	data.ID = types.StringValue(api.ID)
+	data.Secret = types.StringValue(api.Secret)
+	data.ExpiryDate = types.StringValue(api.ExpiryDate)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
+
+func (r *api_keyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, "api_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+	var private apiKeyEphemeralPrivate
+	if err := json.Unmarshal(b, &private); err != nil {
+		resp.Diagnostics.AddError("failed to parse private data", err.Error())
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/v1/applications/%s/api-keys/%s", private.ApplicationID, private.ID)
+	_, _ = r.apiRequest(ctx, http.MethodDelete, apiPath, nil, nil, &resp.Diagnostics, Allow404())
+}
@@ -0,0 +1,130 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+)
+
+var apiKeyEphemeralStep1 = `
+ephemeral "kaleido_platform_api_key" "key1" {
+    application_id = "app1"
+    name = "helm-deploy"
+    expires_in = "15m"
+}
+
+provider "echo" {
+    data = ephemeral.kaleido_platform_api_key.key1
+}
+
+resource "echo" "key1" {}
+`
+
+var apiKeyEphemeralRevokeOnClose = `
+ephemeral "kaleido_platform_api_key" "key1" {
+    application_id = "app1"
+    name = "plan-check"
+    revoke_on_close = true
+}
+
+provider "echo" {
+    data = ephemeral.kaleido_platform_api_key.key1
+}
+
+resource "echo" "key1" {}
+`
+
+var apiKeyEphemeralStep2 = `
+ephemeral "kaleido_platform_api_key" "key1" {
+    application_id = "app1"
+    name = "helm-deploy"
+    expires_in = "soon"
+}
+`
+
+// testAccProvidersWithEcho adds the echo provider, which is used to check the results of
+// ephemeral resources as they are never stored in state
+func testAccProvidersWithEcho() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"kaleido": testAccProviders["kaleido"],
+		"echo":    echoprovider.NewProviderServer(),
+	}
+}
+
+func TestAPIKeyEphemeral(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		// API keys are left to expire, as they are handed on to other systems
+		assert.NotEmpty(t, mp.apiKeys)
+		for _, apiKey := range mp.apiKeys {
+			assert.Equal(t, "helm-deploy", apiKey.Name)
+			assert.NotEmpty(t, apiKey.ExpiryDate)
+		}
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_10_0)},
+		ProtoV6ProviderFactories: testAccProvidersWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + apiKeyEphemeralStep1,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.key1", tfjsonpath.New("data").AtMapKey("secret"), knownvalue.StringExact("secret_password")),
+					statecheck.ExpectKnownValue("echo.key1", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("helm-deploy")),
+					statecheck.ExpectKnownValue("echo.key1", tfjsonpath.New("data").AtMapKey("expiry_date"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`))),
+				},
+			},
+			{
+				Config:      providerConfig + apiKeyEphemeralStep2,
+				ExpectError: regexp.MustCompile(`'soon' is not a valid positive duration`),
+			},
+		},
+	})
+}
+
+func TestAPIKeyEphemeralRevokeOnClose(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		// Every API key minted during the test must have been deleted again on close
+		assert.Empty(t, mp.apiKeys)
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_10_0)},
+		ProtoV6ProviderFactories: testAccProvidersWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + apiKeyEphemeralRevokeOnClose,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.key1", tfjsonpath.New("data").AtMapKey("secret"), knownvalue.StringExact("secret_password")),
+					statecheck.ExpectKnownValue("echo.key1", tfjsonpath.New("data").AtMapKey("revoke_on_close"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ApplicationDatasourceModel struct {
+	ID           types.String                   `tfsdk:"id"`
+	Name         types.String                   `tfsdk:"name"`
+	OAuthEnabled types.Bool                     `tfsdk:"oauth_enabled"`
+	AdminEnabled types.Bool                     `tfsdk:"admin_enabled"`
+	OAuth        *ApplicationOAuthResourceModel `tfsdk:"oauth"`
+}
+
+func (api *ApplicationAPIModel) toDatasourceData(data *ApplicationDatasourceModel) {
+	var res ApplicationResourceModel
+	api.toData(&res)
+	data.ID = res.ID
+	data.Name = res.Name
+	data.OAuthEnabled = types.BoolValue(res.OAuthEnabled.ValueBool())
+	data.AdminEnabled = types.BoolValue(res.AdminEnabled.ValueBool())
+	data.OAuth = res.OAuth
+}
+
+func ApplicationDatasourceModelFactory() datasource.DataSource {
+	return &applicationDatasource{}
+}
+
+type applicationDatasource struct {
+	commonDataSource
+}
+
+func (r *applicationDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_application"
+}
+
+func (r *applicationDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing application in the account by name or ID, with its OAuth settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Application ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Application Name. Exactly one of `id` or `name` must be set",
+			},
+			"oauth_enabled": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"admin_enabled": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"oauth": &schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "OAuth settings used to validate tokens presented by the application",
+				Attributes: map[string]schema.Attribute{
+					"aud": &schema.StringAttribute{
+						Computed: true,
+					},
+					"azp": &schema.StringAttribute{
+						Computed: true,
+					},
+					"ca_certificate": &schema.StringAttribute{
+						Computed: true,
+					},
+					"issuer": &schema.StringAttribute{
+						Computed: true,
+					},
+					"jwks": &schema.StringAttribute{
+						Computed: true,
+					},
+					"jwks_endpoint": &schema.StringAttribute{
+						Computed: true,
+					},
+					"oidc_config_url": &schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *applicationDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *applicationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api ApplicationAPIModel
+	if !lookupByNameOrID(ctx, &r.commonDataSource, "/api/v1/applications", "application", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
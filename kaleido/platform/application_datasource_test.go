@@ -0,0 +1,73 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var applicationDataStep1 = `
+data "kaleido_platform_application" "app1" {
+    name = "app1"
+}
+`
+
+func TestApplicationData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	enabled := true
+	mp.applications["app1_id"] = &ApplicationAPIModel{
+		ID:          "app1_id",
+		Name:        "app1",
+		EnableOAuth: &enabled,
+		OAuth: &ApplicationOAuthAPIModel{
+			Issuer:       "https://idp.example.com",
+			JWKSEndpoint: "https://idp.example.com/jwks",
+			Audience:     "kaleido",
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/applications",
+			"GET /api/v1/applications",
+			"GET /api/v1/applications",
+		})
+		mp.server.Close()
+	}()
+
+	appData := "data.kaleido_platform_application.app1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + applicationDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(appData, "id", "app1_id"),
+					resource.TestCheckResourceAttr(appData, "oauth_enabled", "true"),
+					resource.TestCheckResourceAttr(appData, "admin_enabled", "false"),
+					resource.TestCheckResourceAttr(appData, "oauth.issuer", "https://idp.example.com"),
+					resource.TestCheckResourceAttr(appData, "oauth.jwks_endpoint", "https://idp.example.com/jwks"),
+					resource.TestCheckResourceAttr(appData, "oauth.aud", "kaleido"),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listApplications(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.applications, "")
+}
@@ -201,3 +201,7 @@ func (r *arsNamespaceResource) Delete(ctx context.Context, req resource.DeleteRe
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data), nil, nil, &resp.Diagnostics, Allow404())
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *arsNamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
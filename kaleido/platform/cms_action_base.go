@@ -21,7 +21,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
 
 type CMSActionBaseAPIModel struct {
@@ -67,23 +66,23 @@ func (r *cms_action_baseResource) apiPath(data CMSActionResourceBaseAccessor) st
 func (r *cms_action_baseResource) waitForActionStatus(ctx context.Context, data CMSActionResourceBaseAccessor, api CMSActionAPIBaseAccessor, diagnostics *diag.Diagnostics) {
 	path := r.apiPath(data)
 	cancelInfo := APICancelInfo()
-	_ = kaleidobase.Retry.Do(ctx, fmt.Sprintf("build-check %s", path), func(attempt int) (retry bool, err error) {
-		ok, statusCode := r.apiRequest(ctx, http.MethodGet, path, nil, &api, diagnostics, cancelInfo)
+	r.waitForStatus(ctx, fmt.Sprintf("build-check %s", path), path, "succeeded", diagnostics, func(attemptDiags *diag.Diagnostics) (string, bool, error) {
+		ok, statusCode := r.apiRequest(ctx, http.MethodGet, path, nil, &api, attemptDiags, cancelInfo)
 		if !ok {
 			if statusCode == 429 {
-				return true, fmt.Errorf("rate limit exceeded")
+				return "", true, fmt.Errorf("rate limit exceeded")
 			}
-			return false, fmt.Errorf("action-check failed") // already set in diag
+			return "", false, fmt.Errorf("action-check failed") // already set in diag
 		}
 		cancelInfo.CancelInfo = fmt.Sprintf("waiting for completion - status: %s", api.OutputBase().Status)
 		switch api.OutputBase().Status {
 		case "succeeded":
-			return false, nil
+			return api.OutputBase().Status, false, nil
 		case "failed":
-			diagnostics.AddError("action failed", api.OutputBase().Error)
-			return false, fmt.Errorf("action failed")
+			attemptDiags.AddError("action failed", api.OutputBase().Error)
+			return api.OutputBase().Status, false, fmt.Errorf("action failed")
 		default:
-			return true, fmt.Errorf("not ready yet")
+			return api.OutputBase().Status, true, fmt.Errorf("not ready yet")
 		}
 	})
 }
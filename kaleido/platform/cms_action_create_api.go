@@ -17,6 +17,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,18 +29,19 @@ import (
 )
 
 type CMSActionCreateAPIResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Environment      types.String `tfsdk:"environment"`
-	Service          types.String `tfsdk:"service"`
-	Build            types.String `tfsdk:"build"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	FireFlyNamespace types.String `tfsdk:"firefly_namespace"`
-	APIName          types.String `tfsdk:"api_name"`
-	ContractAddress  types.String `tfsdk:"contract_address"`
-	APIID            types.String `tfsdk:"api_id"`
-	Publish          types.String `tfsdk:"publish"`
-	IgnoreDestroy    types.Bool   `tfsdk:"ignore_destroy"`
+	ID               types.String   `tfsdk:"id"`
+	Environment      types.String   `tfsdk:"environment"`
+	Service          types.String   `tfsdk:"service"`
+	Build            types.String   `tfsdk:"build"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	FireFlyNamespace types.String   `tfsdk:"firefly_namespace"`
+	APIName          types.String   `tfsdk:"api_name"`
+	ContractAddress  types.String   `tfsdk:"contract_address"`
+	APIID            types.String   `tfsdk:"api_id"`
+	Publish          types.String   `tfsdk:"publish"`
+	IgnoreDestroy    types.Bool     `tfsdk:"ignore_destroy"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CMSActionCreateAPIAPIModel struct {
@@ -92,8 +94,11 @@ func (r *cms_action_createapiResource) Metadata(_ context.Context, _ resource.Me
 	resp.TypeName = "kaleido_platform_cms_action_createapi"
 }
 
-func (r *cms_action_createapiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *cms_action_createapiResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -182,6 +187,13 @@ func (r *cms_action_createapiResource) Create(ctx context.Context, req resource.
 
 	var data CMSActionCreateAPIResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api CMSActionCreateAPIAPIModel
 	data.toAPI(&api)
@@ -202,6 +214,13 @@ func (r *cms_action_createapiResource) Update(ctx context.Context, req resource.
 	var data CMSActionCreateAPIResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Update from plan
 	var api CMSActionCreateAPIAPIModel
@@ -217,6 +236,13 @@ func (r *cms_action_createapiResource) Update(ctx context.Context, req resource.
 func (r *cms_action_createapiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data CMSActionCreateAPIResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api CMSActionCreateAPIAPIModel
 	api.ID = data.ID.ValueString()
@@ -236,6 +262,13 @@ func (r *cms_action_createapiResource) Read(ctx context.Context, req resource.Re
 func (r *cms_action_createapiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data CMSActionCreateAPIResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	if !data.IgnoreDestroy.IsNull() && data.IgnoreDestroy.ValueBool() {
 		return
@@ -245,3 +278,7 @@ func (r *cms_action_createapiResource) Delete(ctx context.Context, req resource.
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *cms_action_createapiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
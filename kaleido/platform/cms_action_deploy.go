@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,22 +29,23 @@ import (
 )
 
 type CMSActionDeployResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Environment        types.String `tfsdk:"environment"`
-	Service            types.String `tfsdk:"service"`
-	Build              types.String `tfsdk:"build"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	FireFlyNamespace   types.String `tfsdk:"firefly_namespace"`
-	TransactionManager types.String `tfsdk:"transaction_manager"`
-	SigningKey         types.String `tfsdk:"signing_key"`
-	ParamsJSON         types.String `tfsdk:"params_json"`
-	TransactionID      types.String `tfsdk:"transaction_id"`
-	IdempotencyKey     types.String `tfsdk:"idempotency_key"`
-	OperationID        types.String `tfsdk:"operation_id"`
-	ContractAddress    types.String `tfsdk:"contract_address"`
-	BlockNumber        types.String `tfsdk:"block_number"`
-	IgnoreDestroy      types.Bool   `tfsdk:"ignore_destroy"`
+	ID                 types.String   `tfsdk:"id"`
+	Environment        types.String   `tfsdk:"environment"`
+	Service            types.String   `tfsdk:"service"`
+	Build              types.String   `tfsdk:"build"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	FireFlyNamespace   types.String   `tfsdk:"firefly_namespace"`
+	TransactionManager types.String   `tfsdk:"transaction_manager"`
+	SigningKey         types.String   `tfsdk:"signing_key"`
+	ParamsJSON         jsonStringVal  `tfsdk:"params_json"`
+	TransactionID      types.String   `tfsdk:"transaction_id"`
+	IdempotencyKey     types.String   `tfsdk:"idempotency_key"`
+	OperationID        types.String   `tfsdk:"operation_id"`
+	ContractAddress    types.String   `tfsdk:"contract_address"`
+	BlockNumber        types.String   `tfsdk:"block_number"`
+	IgnoreDestroy      types.Bool     `tfsdk:"ignore_destroy"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CMSActionDeployAPIModel struct {
@@ -100,8 +102,11 @@ func (r *cms_action_deployResource) Metadata(_ context.Context, _ resource.Metad
 	resp.TypeName = "kaleido_platform_cms_action_deploy"
 }
 
-func (r *cms_action_deployResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *cms_action_deployResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -138,6 +143,7 @@ func (r *cms_action_deployResource) Schema(_ context.Context, _ resource.SchemaR
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"params_json": &schema.StringAttribute{
+				CustomType:    jsonStringType{},
 				Optional:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
@@ -200,6 +206,13 @@ func (r *cms_action_deployResource) Create(ctx context.Context, req resource.Cre
 
 	var data CMSActionDeployResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api CMSActionDeployAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
@@ -222,6 +235,13 @@ func (r *cms_action_deployResource) Update(ctx context.Context, req resource.Upd
 	var data CMSActionDeployResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Update from plan
 	var api CMSActionDeployAPIModel
@@ -245,6 +265,13 @@ func (r *cms_action_deployResource) Update(ctx context.Context, req resource.Upd
 func (r *cms_action_deployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data CMSActionDeployResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api CMSActionDeployAPIModel
 	api.ID = data.ID.ValueString()
@@ -264,6 +291,13 @@ func (r *cms_action_deployResource) Read(ctx context.Context, req resource.ReadR
 func (r *cms_action_deployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data CMSActionDeployResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	if !data.IgnoreDestroy.IsNull() && data.IgnoreDestroy.ValueBool() {
 		return
@@ -273,3 +307,7 @@ func (r *cms_action_deployResource) Delete(ctx context.Context, req resource.Del
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *cms_action_deployResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
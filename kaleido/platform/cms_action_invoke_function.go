@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,22 +29,23 @@ import (
 )
 
 type CMSActionInvokeFunctionResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Environment      types.String `tfsdk:"environment"`
-	Service          types.String `tfsdk:"service"`
-	Build            types.String `tfsdk:"build"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	FireFlyNamespace types.String `tfsdk:"firefly_namespace"`
-	SigningKey       types.String `tfsdk:"signing_key"`
-	ParamsJSON       types.String `tfsdk:"params_json"`
-	MethodPath       types.String `tfsdk:"method_path"`
-	ContractAddress  types.String `tfsdk:"contract_address"`
-	TransactionID    types.String `tfsdk:"transaction_id"`
-	IdempotencyKey   types.String `tfsdk:"idempotency_key"`
-	OperationID      types.String `tfsdk:"operation_id"`
-	BlockNumber      types.String `tfsdk:"block_number"`
-	IgnoreDestroy    types.Bool   `tfsdk:"ignore_destroy"`
+	ID               types.String   `tfsdk:"id"`
+	Environment      types.String   `tfsdk:"environment"`
+	Service          types.String   `tfsdk:"service"`
+	Build            types.String   `tfsdk:"build"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	FireFlyNamespace types.String   `tfsdk:"firefly_namespace"`
+	SigningKey       types.String   `tfsdk:"signing_key"`
+	ParamsJSON       jsonStringVal  `tfsdk:"params_json"`
+	MethodPath       types.String   `tfsdk:"method_path"`
+	ContractAddress  types.String   `tfsdk:"contract_address"`
+	TransactionID    types.String   `tfsdk:"transaction_id"`
+	IdempotencyKey   types.String   `tfsdk:"idempotency_key"`
+	OperationID      types.String   `tfsdk:"operation_id"`
+	BlockNumber      types.String   `tfsdk:"block_number"`
+	IgnoreDestroy    types.Bool     `tfsdk:"ignore_destroy"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CMSActionInvokeFunctionAPIModel struct {
@@ -100,8 +102,11 @@ func (r *cms_action_invokefunctionResource) Metadata(_ context.Context, _ resour
 	resp.TypeName = "kaleido_platform_cms_action_invoke_function"
 }
 
-func (r *cms_action_invokefunctionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *cms_action_invokefunctionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -142,6 +147,7 @@ func (r *cms_action_invokefunctionResource) Schema(_ context.Context, _ resource
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"params_json": &schema.StringAttribute{
+				CustomType:    jsonStringType{},
 				Optional:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
@@ -204,6 +210,13 @@ func (r *cms_action_invokefunctionResource) Create(ctx context.Context, req reso
 
 	var data CMSActionInvokeFunctionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api CMSActionInvokeFunctionAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
@@ -226,6 +239,13 @@ func (r *cms_action_invokefunctionResource) Update(ctx context.Context, req reso
 	var data CMSActionInvokeFunctionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Update from plan
 	var api CMSActionInvokeFunctionAPIModel
@@ -249,6 +269,13 @@ func (r *cms_action_invokefunctionResource) Update(ctx context.Context, req reso
 func (r *cms_action_invokefunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data CMSActionInvokeFunctionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api CMSActionInvokeFunctionAPIModel
 	api.ID = data.ID.ValueString()
@@ -268,6 +295,13 @@ func (r *cms_action_invokefunctionResource) Read(ctx context.Context, req resour
 func (r *cms_action_invokefunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data CMSActionInvokeFunctionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	if !data.IgnoreDestroy.IsNull() && data.IgnoreDestroy.ValueBool() {
 		return
@@ -277,3 +311,7 @@ func (r *cms_action_invokefunctionResource) Delete(ctx context.Context, req reso
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *cms_action_invokefunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -54,10 +55,11 @@ type CMSBuildResourceModel struct {
 	ABI                     types.String                     `tfsdk:"abi"`
 	Bytecode                types.String                     `tfsdk:"bytecode"`
 	DevDocs                 types.String                     `tfsdk:"dev_docs"`
-	LibrariesJSON           types.String                     `tfsdk:"libraries_json"`
+	LibrariesJSON           jsonStringVal                    `tfsdk:"libraries_json"`
 	CommitHash              types.String                     `tfsdk:"commit_hash"`
-	CompilationMetadataJSON types.String                     `tfsdk:"compilation_metadata_json"`
+	CompilationMetadataJSON jsonStringVal                    `tfsdk:"compilation_metadata_json"`
 	IgnoreDestroy           types.Bool                       `tfsdk:"ignore_destroy"`
+	Timeouts                timeouts.Value                   `tfsdk:"timeouts"`
 }
 
 type CMSBuildPrecompiledResourceModel struct {
@@ -122,8 +124,15 @@ type CMSBuildSourceCodeAPIModel struct {
 	FileContents string `json:"fileContents,omitempty"`
 }
 
+// Builds compile contracts, which usually takes minutes, so poll less often than the default
+var cmsBuildRetry = kaleidobase.CustomRetry{
+	InitialDelay: 10 * time.Second,
+	MaximumDelay: 60 * time.Second,
+	Factor:       2.0,
+}
+
 func CMSBuildResourceFactory() resource.Resource {
-	return &cms_buildResource{}
+	return &cms_buildResource{commonResource{retryDefaults: &cmsBuildRetry}}
 }
 
 type cms_buildResource struct {
@@ -134,10 +143,13 @@ func (r *cms_buildResource) Metadata(_ context.Context, _ resource.MetadataReque
 	resp.TypeName = "kaleido_platform_cms_build"
 }
 
-func (r *cms_buildResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *cms_buildResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// Version 1: Changed auth_token from Sensitive to WriteOnly
 		Version: 1,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -259,7 +271,8 @@ func (r *cms_buildResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				),
 			},
 			"libraries_json": &schema.StringAttribute{
-				Optional: true,
+				CustomType: jsonStringType{},
+				Optional:   true,
 			},
 			"abi": &schema.StringAttribute{
 				Computed: true,
@@ -274,7 +287,8 @@ func (r *cms_buildResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed: true,
 			},
 			"compilation_metadata_json": &schema.StringAttribute{
-				Computed: true,
+				CustomType: jsonStringType{},
+				Computed:   true,
 			},
 			"optimizer": &schema.SingleNestedAttribute{
 				Optional: true,
@@ -441,10 +455,16 @@ func (r *cms_buildResource) UpgradeState(_ context.Context) map[int64]resource.S
 					ABI:                     oldData.ABI,
 					Bytecode:                oldData.Bytecode,
 					DevDocs:                 oldData.DevDocs,
-					LibrariesJSON:           oldData.LibrariesJSON,
+					LibrariesJSON:           jsonStringVal{StringValue: oldData.LibrariesJSON},
 					CommitHash:              oldData.CommitHash,
-					CompilationMetadataJSON: oldData.CompilationMetadataJSON,
+					CompilationMetadataJSON: jsonStringVal{StringValue: oldData.CompilationMetadataJSON},
 					IgnoreDestroy:           oldData.IgnoreDestroy,
+					Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+						"create": types.StringType,
+						"read":   types.StringType,
+						"update": types.StringType,
+						"delete": types.StringType,
+					})},
 				}
 
 				resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
@@ -548,13 +568,13 @@ func (api *CMSBuildAPIModel) toData(data *CMSBuildResourceModel) {
 	}
 	if api.Libraries != nil {
 		librariesBytes, _ := json.Marshal(api.Libraries)
-		data.LibrariesJSON = types.StringValue(string(librariesBytes))
+		data.LibrariesJSON = newJSONString(string(librariesBytes))
 	}
 	if api.CompilationMetadata != nil {
 		compilationMetadataBytes, _ := json.Marshal(api.CompilationMetadata)
-		data.CompilationMetadataJSON = types.StringValue(string(compilationMetadataBytes))
+		data.CompilationMetadataJSON = newJSONString(string(compilationMetadataBytes))
 	} else {
-		data.CompilationMetadataJSON = types.StringValue("{}")
+		data.CompilationMetadataJSON = newJSONString("{}")
 	}
 }
 
@@ -569,23 +589,23 @@ func (r *cms_buildResource) apiPath(data *CMSBuildResourceModel) string {
 func (r *cms_buildResource) waitForBuildStatus(ctx context.Context, data *CMSBuildResourceModel, api *CMSBuildAPIModel, diagnostics *diag.Diagnostics) {
 	path := r.apiPath(data)
 	cancelInfo := APICancelInfo()
-	_ = kaleidobase.Retry.Do(ctx, fmt.Sprintf("build-check %s", path), func(attempt int) (retry bool, err error) {
-		ok, statusCode := r.apiRequest(ctx, http.MethodGet, path, nil, &api, diagnostics, cancelInfo)
+	r.waitForStatus(ctx, fmt.Sprintf("build-check %s", path), path, "succeeded", diagnostics, func(attemptDiags *diag.Diagnostics) (string, bool, error) {
+		ok, statusCode := r.apiRequest(ctx, http.MethodGet, path, nil, &api, attemptDiags, cancelInfo)
 		if !ok {
 			if statusCode == 429 {
-				return true, fmt.Errorf("rate limit exceeded")
+				return "", true, fmt.Errorf("rate limit exceeded")
 			}
-			return false, fmt.Errorf("build-check failed") // already set in diag
+			return "", false, fmt.Errorf("build-check failed") // already set in diag
 		}
 		cancelInfo.CancelInfo = fmt.Sprintf("(waiting for completion - status: %s)", api.Status)
 		switch api.Status {
 		case "succeeded":
-			return false, nil
+			return api.Status, false, nil
 		case "failed":
-			diagnostics.AddError("build failed", api.CompileError)
-			return false, fmt.Errorf("build failed")
+			attemptDiags.AddError("build failed", api.CompileError)
+			return api.Status, false, fmt.Errorf("build failed")
 		default:
-			return true, fmt.Errorf("not ready yet")
+			return api.Status, true, fmt.Errorf("not ready yet")
 		}
 	})
 }
@@ -594,6 +614,13 @@ func (r *cms_buildResource) Create(ctx context.Context, req resource.CreateReque
 
 	var data CMSBuildResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	// WriteOnly attributes are null in the plan - must read from config
 	var authToken types.String
@@ -629,6 +656,13 @@ func (r *cms_buildResource) Update(ctx context.Context, req resource.UpdateReque
 	var data CMSBuildResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// WriteOnly attributes are null in the plan - must read from config
 	var authToken types.String
@@ -660,6 +694,13 @@ func (r *cms_buildResource) Update(ctx context.Context, req resource.UpdateReque
 func (r *cms_buildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data CMSBuildResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api CMSBuildAPIModel
 	api.ID = data.ID.ValueString()
@@ -687,6 +728,13 @@ func (r *cms_buildResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *cms_buildResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data CMSBuildResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	if !data.IgnoreDestroy.IsNull() && data.IgnoreDestroy.ValueBool() {
 		return
@@ -696,3 +744,7 @@ func (r *cms_buildResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *cms_buildResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -0,0 +1,164 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CMSBuildDatasourceModel struct {
+	ID                      types.String  `tfsdk:"id"`
+	Environment             types.String  `tfsdk:"environment"`
+	Service                 types.String  `tfsdk:"service"`
+	Name                    types.String  `tfsdk:"name"`
+	Path                    types.String  `tfsdk:"path"`
+	Description             types.String  `tfsdk:"description"`
+	Status                  types.String  `tfsdk:"status"`
+	EVMVersion              types.String  `tfsdk:"evm_version"`
+	SolcVersion             types.String  `tfsdk:"solc_version"`
+	ABI                     types.String  `tfsdk:"abi"`
+	Bytecode                types.String  `tfsdk:"bytecode"`
+	DevDocs                 types.String  `tfsdk:"dev_docs"`
+	CommitHash              types.String  `tfsdk:"commit_hash"`
+	CompilationMetadataJSON jsonStringVal `tfsdk:"compilation_metadata_json"`
+}
+
+func (api *CMSBuildAPIModel) toDatasourceData(data *CMSBuildDatasourceModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Path = types.StringValue(api.Path)
+	data.Description = types.StringValue(api.Description)
+	data.Status = types.StringValue(api.Status)
+	data.EVMVersion = types.StringValue(api.EVMVersion)
+	data.SolcVersion = types.StringValue(api.SolcVersion)
+	abiBytes, _ := json.Marshal(api.ABI)
+	data.ABI = types.StringValue(string(abiBytes))
+	data.Bytecode = types.StringValue(api.Bytecode)
+	devDocsBytes, _ := json.Marshal(api.DevDocs)
+	data.DevDocs = types.StringValue(string(devDocsBytes))
+	if api.GitHub != nil {
+		data.CommitHash = types.StringValue(api.GitHub.CommitHash)
+	} else {
+		data.CommitHash = types.StringValue("")
+	}
+	if api.CompilationMetadata != nil {
+		compilationMetadataBytes, _ := json.Marshal(api.CompilationMetadata)
+		data.CompilationMetadataJSON = newJSONString(string(compilationMetadataBytes))
+	} else {
+		data.CompilationMetadataJSON = newJSONString("{}")
+	}
+}
+
+func CMSBuildDatasourceModelFactory() datasource.DataSource {
+	return &cms_buildDatasource{}
+}
+
+type cms_buildDatasource struct {
+	commonDataSource
+}
+
+func (r *cms_buildDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_cms_build"
+}
+
+func (r *cms_buildDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing contract build in a contract manager by name or ID, to use its ABI and bytecode.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Contract Manager Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Build ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Build Display Name. Exactly one of `id` or `name` must be set",
+			},
+			"path": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Path of the contract the build belongs to",
+			},
+			"description": &schema.StringAttribute{
+				Computed: true,
+			},
+			"status": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Build status. The ABI and bytecode are only available once the build has `succeeded`",
+			},
+			"evm_version": &schema.StringAttribute{
+				Computed: true,
+			},
+			"solc_version": &schema.StringAttribute{
+				Computed: true,
+			},
+			"abi": &schema.StringAttribute{
+				Computed: true,
+			},
+			"bytecode": &schema.StringAttribute{
+				Computed: true,
+			},
+			"dev_docs": &schema.StringAttribute{
+				Computed: true,
+			},
+			"commit_hash": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Commit hash of the source, for builds compiled from GitHub",
+			},
+			"compilation_metadata_json": &schema.StringAttribute{
+				Computed:   true,
+				CustomType: jsonStringType{},
+			},
+		},
+	}
+}
+
+func (r *cms_buildDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *cms_buildDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CMSBuildDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api CMSBuildAPIModel
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/builds", data.Environment.ValueString(), data.Service.ValueString())
+	if !lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "build", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
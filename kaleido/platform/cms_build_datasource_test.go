@@ -0,0 +1,82 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var cmsBuildDataStep1 = `
+data "kaleido_platform_cms_build" "build1" {
+    environment = "env1"
+    service = "service1"
+    name = "build1"
+}
+`
+
+func TestCMSBuildData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.cmsBuilds["env1/service1/build1_id"] = &CMSBuildAPIModel{
+		ID:          "build1_id",
+		Name:        "build1",
+		Path:        "erc20",
+		Status:      "succeeded",
+		SolcVersion: "0.8.24",
+		ABI:         []interface{}{map[string]interface{}{"type": "constructor"}},
+		Bytecode:    "0x608060",
+		DevDocs:     map[string]interface{}{"title": "ERC20"},
+		GitHub: &CMSBuildGithubAPIModel{
+			ContractURL: "https://github.com/example/contracts/blob/main/ERC20.sol",
+			CommitHash:  "f32b4a1",
+		},
+		CompilationMetadata: map[string]interface{}{"language": "Solidity"},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/builds",
+			"GET /endpoint/{env}/{service}/rest/api/v1/builds",
+			"GET /endpoint/{env}/{service}/rest/api/v1/builds",
+		})
+		mp.server.Close()
+	}()
+
+	buildData := "data.kaleido_platform_cms_build.build1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + cmsBuildDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(buildData, "id", "build1_id"),
+					resource.TestCheckResourceAttr(buildData, "path", "erc20"),
+					resource.TestCheckResourceAttr(buildData, "status", "succeeded"),
+					resource.TestCheckResourceAttr(buildData, "abi", `[{"type":"constructor"}]`),
+					resource.TestCheckResourceAttr(buildData, "bytecode", "0x608060"),
+					resource.TestCheckResourceAttr(buildData, "dev_docs", `{"title":"ERC20"}`),
+					resource.TestCheckResourceAttr(buildData, "commit_hash", "f32b4a1"),
+					resource.TestCheckResourceAttr(buildData, "compilation_metadata_json", `{"language":"Solidity"}`),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listCMSBuilds(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.cmsBuilds, mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/")
+}
@@ -17,6 +17,7 @@ import (
 	_ "embed"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
 
 var cms_buildStep1 = `
@@ -336,12 +339,92 @@ func TestCMSBuildPreCompiled(t *testing.T) {
 	})
 }
 
+var cms_buildTimeout = `
+resource "kaleido_platform_cms_build" "cms_build1" {
+    environment = "env1"
+	service = "service1"
+    type = "precompiled"
+    name = "build1"
+    path = "some/path"
+	precompiled = {
+		abi = jsonencode([{"some":"abi"}])
+		bytecode = "0xAAABBBCCCDDD"
+	}
+	timeouts {
+		create = "1s"
+	}
+}
+`
+
+func TestCMSBuildTimeout(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer mp.server.Close()
+	mp.cmsBuildsNeverComplete = true
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + cms_buildTimeout,
+				ExpectError: regexp.MustCompile(`timed out waiting for status succeeded`),
+			},
+		},
+	})
+}
+
+func TestCMSBuildRetryMaxAttempts(t *testing.T) {
+	mp, _ := testSetup(t)
+	defer mp.server.Close()
+	mp.cmsBuildsNeverComplete = true
+
+	providerConfig := fmt.Sprintf(`
+provider "kaleido" {
+	platform_api = "%s"
+	retry_initial_delay = "10ms"
+	retry_maximum_delay = "10ms"
+	retry_max_attempts = 3
+}
+`, mp.server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + cms_buildPrecompiled,
+				ExpectError: regexp.MustCompile(`gave up waiting for status succeeded`),
+			},
+		},
+	})
+}
+
+func TestCMSBuildRetryDefaults(t *testing.T) {
+	r := CMSBuildResourceFactory().(*cms_buildResource)
+	r.ProviderData = &kaleidobase.ProviderData{}
+	assert.Equal(t, cmsBuildRetry, *r.retry())
+
+	// Provider settings override the defaults for the resource
+	initialDelay := time.Second
+	r.RetryOverrides.InitialDelay = &initialDelay
+	assert.Equal(t, time.Second, r.retry().InitialDelay)
+	assert.Equal(t, cmsBuildRetry.MaximumDelay, r.retry().MaximumDelay)
+
+	// Resources without their own defaults use the provider-wide default
+	k := AMSTaskResourceFactory().(*ams_taskResource)
+	k.ProviderData = &kaleidobase.ProviderData{}
+	assert.Equal(t, kaleidobase.DefaultRetry, *k.retry())
+}
+
 func (mp *mockPlatform) getCMSBuild(res http.ResponseWriter, req *http.Request) {
 	obj := mp.cmsBuilds[mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/"+mux.Vars(req)["build"]]
 	if obj == nil {
 		mp.respond(res, nil, 404)
 	} else {
 		mp.respond(res, obj, 200)
+		if mp.cmsBuildsNeverComplete {
+			return
+		}
 		// Next time we'll complete the build
 		obj.Status = "succeeded"
 
@@ -16,18 +16,33 @@ package platform
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
-	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"gopkg.in/yaml.v3"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
@@ -36,11 +51,30 @@ type APIRequestOption struct {
 	allow404         bool
 	captureLastError bool
 	yamlBody         bool
+	idempotencyKey   string
+	errorFieldPaths  map[string]path.Path
+	errorSchema      attributeSchema
 	CancelInfo       string
 }
 
+// attributeSchema is the part of a resource schema used to check that a field named in a
+// platform error has a matching attribute, which the Schema of a plan or state satisfies
+type attributeSchema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
 type commonResource struct {
 	*kaleidobase.ProviderData
+	// retryDefaults is the retry policy for waits on this resource, where it differs from
+	// kaleidobase.DefaultRetry. Retry settings in the provider configuration override it.
+	retryDefaults *kaleidobase.CustomRetry
+}
+
+func (r *commonResource) retry() *kaleidobase.CustomRetry {
+	if r.retryDefaults != nil {
+		return r.Retry(*r.retryDefaults)
+	}
+	return r.Retry(kaleidobase.DefaultRetry)
 }
 
 func (r *commonResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,10 +85,32 @@ type commonDataSource struct {
 	*kaleidobase.ProviderData
 }
 
+func (r *commonDataSource) retry() *kaleidobase.CustomRetry {
+	return r.Retry(kaleidobase.DefaultRetry)
+}
+
 func (r *commonDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	r.ProviderData = kaleidobase.ConfigureProviderData(req.ProviderData, &resp.Diagnostics)
 }
 
+type commonEphemeralResource struct {
+	*kaleidobase.ProviderData
+}
+
+func (r *commonEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.ProviderData = kaleidobase.ConfigureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// commonAction shares the request and wait helpers of commonResource, as actions operate on the
+// same objects as the resources
+type commonAction struct {
+	commonResource
+}
+
+func (r *commonAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	r.ProviderData = kaleidobase.ConfigureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
 func Allow404() *APIRequestOption {
 	return &APIRequestOption{
 		allow404: true,
@@ -67,245 +123,447 @@ func YAMLBody() *APIRequestOption {
 	}
 }
 
+// IdempotencyKey marks a POST as safe to retry on a transient failure, because the
+// server de-duplicates submissions with the same key
+func IdempotencyKey(idempotencyKey string) *APIRequestOption {
+	return &APIRequestOption{
+		idempotencyKey: idempotencyKey,
+	}
+}
+
+// ErrorFieldPath attaches platform errors reported against the named top-level request field
+// to an attribute, where it is not simply the field name in snake case (such as `config`
+// being set from `config_json`)
+func ErrorFieldPath(apiField string, attributePath path.Path) *APIRequestOption {
+	return &APIRequestOption{
+		errorFieldPaths: map[string]path.Path{apiField: attributePath},
+	}
+}
+
+// ErrorAttributes attaches platform errors reported against a top-level request field with no
+// ErrorFieldPath to the attribute of the same name in snake case, where the schema has one
+func ErrorAttributes(s attributeSchema) *APIRequestOption {
+	return &APIRequestOption{
+		errorSchema: s,
+	}
+}
+
 func APICancelInfo() *APIRequestOption {
 	return &APIRequestOption{
 		captureLastError: true,
 	}
 }
 
+func (r *commonResource) apiClient() *platformClient {
+	return newPlatformClient(r.Platform)
+}
+
 func (r *commonResource) apiRequest(ctx context.Context, method, path string, body, result interface{}, diagnostics *diag.Diagnostics, options ...*APIRequestOption) (bool, int) {
-	var bodyBytes []byte
-	var err error
-	bodyString := ""
-	isYaml := false
-	for _, o := range options {
-		isYaml = isYaml || o.yamlBody
-	}
-	tflog.Debug(ctx, fmt.Sprintf("BODY %s", body))
-
-	if body != nil {
-		switch tBody := body.(type) {
-		case []byte:
-			bodyBytes = tBody
-			bodyString = string(tBody)
-			tflog.Debug(ctx, fmt.Sprintf("BODY BYTES %s", bodyString))
-
-		case string:
-			bodyBytes = []byte(tBody)
-			bodyString = tBody
-			tflog.Debug(ctx, fmt.Sprintf("BODY STRING %s", bodyString))
-
-		default:
-			if isYaml {
-				bodyBytes, err = yaml.Marshal(body)
-				tflog.Debug(ctx, fmt.Sprintf("BODY YAML %s", bodyBytes))
-			} else {
-				bodyBytes, err = json.Marshal(body)
+	return requestWithDiagnostics(ctx, r.apiClient(), method, path, body, result, diagnostics, options...)
+}
+
+// requestWithDiagnostics sends a request with the platform client, and reports any failure as
+// an error diagnostic. It returns whether the request succeeded, which includes a 404 when
+// Allow404 is set, and the status code or -1 if no response was received.
+func requestWithDiagnostics(ctx context.Context, client *platformClient, method, path string, body, result interface{}, diagnostics *diag.Diagnostics, options ...*APIRequestOption) (bool, int) {
+	statusCode, err := client.Do(ctx, method, path, body, result, options...)
+	if err == nil {
+		return true, statusCode
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if errors.Is(apiErr, ErrNotFound) {
+			for _, o := range options {
+				if o.allow404 {
+					return true, statusCode
+				}
 			}
 		}
-		if err == nil {
-			bodyString = string(bodyBytes)
+		addAPIErrorDiagnostic(ctx, apiErr, diagnostics, options...)
+		return false, statusCode
+	}
+	errorInfo := err.Error()
+	if ctx.Err() != nil {
+		for _, o := range options {
+			if o.CancelInfo != "" {
+				errorInfo = fmt.Sprintf("%s %s", errorInfo, o.CancelInfo)
+			}
 		}
+		diagnostics.AddError(
+			fmt.Sprintf("%s cancelled", method),
+			errorInfo,
+		)
+	} else {
+		diagnostics.AddError(
+			fmt.Sprintf("%s failed", method),
+			errorInfo,
+		)
 	}
-	tflog.Debug(ctx, fmt.Sprintf("--> %s %s%s %s", method, r.Platform.BaseURL, path, bodyString))
+	return false, statusCode
+}
 
-	var res *resty.Response
-	if err == nil {
-		req := r.Platform.R().
-			SetContext(ctx).
-			SetDoNotParseResponse(true)
+var apiFieldSegment = regexp.MustCompile(`^[/.]?([A-Za-z0-9_-]+)`)
 
-		if isYaml {
-			req = req.SetHeader("Content-type", "application/x-yaml")
-		} else {
-			req = req.SetHeader("Content-type", "application/json")
+// attributePathForAPIField maps a field named in a platform error, such as `config.nodes[0]`
+// or `/config/nodes/0`, to the resource attribute it was set from. Fields that are not mapped
+// with ErrorFieldPath only match an attribute in the schema passed with ErrorAttributes.
+func attributePathForAPIField(ctx context.Context, field string, options ...*APIRequestOption) (path.Path, bool) {
+	m := apiFieldSegment.FindStringSubmatch(field)
+	if m == nil {
+		return path.Empty(), false
+	}
+	for _, o := range options {
+		if p, ok := o.errorFieldPaths[m[1]]; ok {
+			return p, true
+		}
+	}
+	attributePath := path.Root(toSnakeCase(m[1]))
+	for _, o := range options {
+		if o.errorSchema == nil {
+			continue
 		}
-		if bodyBytes != nil {
-			req = req.SetBody(bodyBytes)
+		if _, diags := o.errorSchema.TypeAtPath(ctx, attributePath); !diags.HasError() {
+			return attributePath, true
 		}
-		res, err = req.Execute(method, path)
 	}
-	var statusString string
-	var rawBytes []byte
-	statusCode := -1
-	if err != nil {
-		statusString = err.Error()
-	} else {
-		statusCode = res.StatusCode()
-		statusString = fmt.Sprintf("%d %s", statusCode, res.Status())
+	return path.Empty(), false
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, c := range s {
+		if unicode.IsUpper(c) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			c = unicode.ToLower(c)
+		} else if c == '-' {
+			c = '_'
+		}
+		b.WriteRune(c)
 	}
-	tflog.Debug(ctx, fmt.Sprintf("<-- %s %s%s [%s]", method, r.Platform.BaseURL, path, statusString))
-	if res != nil && res.RawResponse != nil {
-		defer res.RawResponse.Body.Close()
-		rawBytes, err = io.ReadAll(res.RawBody())
+	return b.String()
+}
+
+// addAPIErrorDiagnostic reports an error response from the platform, with the platform's
+// error message as the summary and its code and request ID in the detail. Errors against
+// a request field are attached to the matching attribute.
+func addAPIErrorDiagnostic(ctx context.Context, apiErr *APIError, diagnostics *diag.Diagnostics, options ...*APIRequestOption) {
+	summary := fmt.Sprintf("%s failed", apiErr.Method)
+	if apiErr.PlatformError != nil {
+		summary = fmt.Sprintf("%s failed: %s", apiErr.Method, apiErr.Message())
 	}
-	if rawBytes != nil {
-		tflog.Debug(ctx, fmt.Sprintf("Response: %s", rawBytes))
+	detail := []string{
+		fmt.Sprintf("%s %s returned status code %d: %s", apiErr.Method, apiErr.Path, apiErr.StatusCode, apiErr.Message()),
 	}
-	if err == nil && res.IsSuccess() && result != nil {
-		err = json.Unmarshal(rawBytes, &result)
+	if code := apiErr.Code(); code != "" {
+		detail = append(detail, fmt.Sprintf("Error code: %s", code))
 	}
-	ok := true
-	if err != nil {
-		ok = false
-		isCancelled := false
-		select {
-		case <-ctx.Done():
-			isCancelled = true
-		default:
+	if field := apiErr.Field(); field != "" {
+		detail = append(detail, fmt.Sprintf("Field: %s", field))
+	}
+	if apiErr.RequestID != "" {
+		detail = append(detail, fmt.Sprintf("Request ID: %s", apiErr.RequestID))
+	}
+	if attributePath, ok := attributePathForAPIField(ctx, apiErr.Field(), options...); ok {
+		diagnostics.AddAttributeError(attributePath, summary, strings.Join(detail, "\n"))
+		return
+	}
+	diagnostics.AddError(summary, strings.Join(detail, "\n"))
+}
+
+func (r *commonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "id")
+}
+
+// importStateFromID parses a composite import ID such as `environment/service/id`, and sets each
+// segment on the named attribute in order. Resources whose API path is scoped by an environment,
+// service (or other parent) list every attribute the Read needs to build that path.
+//
+// Resources with an identity can also be imported from an identity rather than an ID, in which case
+// each attribute is copied from the identity attribute of the same name.
+func importStateFromID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attrs ...string) {
+	if req.ID == "" && req.Identity != nil {
+		for _, attr := range attrs {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attr), &value)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), value)...)
 		}
-		errorInfo := fmt.Sprintf("%s %s failed with error: %s", method, path, err)
-		if isCancelled {
-			for _, o := range options {
-				if o.CancelInfo != "" {
-					errorInfo = fmt.Sprintf("%s %s", errorInfo, o.CancelInfo)
-				}
-			}
-			diagnostics.AddError(
-				fmt.Sprintf("%s cancelled", method),
-				errorInfo,
-			)
-		} else {
-			diagnostics.AddError(
-				fmt.Sprintf("%s failed", method),
-				errorInfo,
+		return
+	}
+	parts := strings.Split(req.ID, "/")
+	expected := strings.Join(attrs, "/")
+	if len(parts) != len(attrs) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form '%s', got: '%s'", expected, req.ID),
+		)
+		return
+	}
+	for i, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected an import ID of the form '%s', got: '%s' (the '%s' segment is empty)", expected, req.ID, attrs[i]),
 			)
+			return
 		}
-	} else if !res.IsSuccess() {
-		isOk404 := false
-		if statusCode == 404 {
-			for _, o := range options {
-				if o.allow404 {
-					isOk404 = true
-				}
-			}
-		}
-		if !isOk404 {
-			ok = false
-			errorInfo := fmt.Sprintf("%s %s returned status code %d: %s", method, path, statusCode, rawBytes)
-			diagnostics.AddError(
-				fmt.Sprintf("%s failed", method),
-				errorInfo,
-			)
+	}
+	for i, attr := range attrs {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), parts[i])...)
+	}
+}
+
+// resourceIdentity lists the attributes that identify a resource, in the same order as the segments
+// of its composite import ID
+type resourceIdentity []string
+
+func (ri resourceIdentity) schema() identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(ri))
+	for _, attr := range ri {
+		attributes[attr] = identityschema.StringAttribute{
+			RequiredForImport: true,
 		}
 	}
-	return ok, statusCode
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
 }
 
-func (r *commonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// set stores the identity of a resource, from values in the same order as the identity attributes
+func (ri resourceIdentity) set(ctx context.Context, identity *tfsdk.ResourceIdentity, diagnostics *diag.Diagnostics, values ...types.String) {
+	if identity == nil {
+		return
+	}
+	for i, attr := range ri {
+		diagnostics.Append(identity.SetAttribute(ctx, path.Root(attr), values[i])...)
+	}
+}
+
+func (r *commonDataSource) apiClient() *platformClient {
+	return newPlatformClient(r.Platform)
 }
 
 func (r *commonDataSource) apiRequest(ctx context.Context, method, path string, body, result interface{}, diagnostics *diag.Diagnostics, options ...*APIRequestOption) (bool, int) {
-	var bodyBytes []byte
-	var err error
-	bodyString := ""
-	isYaml := false
-	for _, o := range options {
-		isYaml = isYaml || o.yamlBody
-	}
-	if body != nil {
-		switch tBody := body.(type) {
-		case []byte:
-			bodyBytes = tBody
-			bodyString = string(tBody)
-		case string:
-			bodyBytes = []byte(tBody)
-			bodyString = tBody
-		default:
-			if isYaml {
-				bodyBytes, err = yaml.Marshal(body)
-			} else {
-				bodyBytes, err = json.Marshal(body)
-			}
+	return requestWithDiagnostics(ctx, r.apiClient(), method, path, body, result, diagnostics, options...)
+}
+
+func (r *commonEphemeralResource) apiClient() *platformClient {
+	return newPlatformClient(r.Platform)
+}
+
+func (r *commonEphemeralResource) apiRequest(ctx context.Context, method, path string, body, result interface{}, diagnostics *diag.Diagnostics, options ...*APIRequestOption) (bool, int) {
+	return requestWithDiagnostics(ctx, r.apiClient(), method, path, body, result, diagnostics, options...)
+}
+
+// PlatformListAPIModel is the envelope returned by the platform list endpoints
+type PlatformListAPIModel[T any] struct {
+	Count int `json:"count"`
+	Items []T `json:"items"`
+}
+
+// platformListPageSize is the number of items requested in each page when listing a collection
+var platformListPageSize = 100
+
+// apiRequester is implemented by the resources and data sources that call the platform API
+type apiRequester interface {
+	apiRequest(ctx context.Context, method, path string, body, result interface{}, diagnostics *diag.Diagnostics, options ...*APIRequestOption) (bool, int)
+}
+
+// listAll reads every page of the collection at collectionPath, filtered by the query parameters
+// in filters
+func listAll[T any](ctx context.Context, r apiRequester, collectionPath string, filters url.Values, diagnostics *diag.Diagnostics) ([]T, bool) {
+	items := []T{}
+	for skip := 0; ; skip += platformListPageSize {
+		query := url.Values{}
+		for k, v := range filters {
+			query[k] = v
 		}
-		if err == nil {
-			bodyString = string(bodyBytes)
+		query.Set("limit", strconv.Itoa(platformListPageSize))
+		query.Set("skip", strconv.Itoa(skip))
+		var page PlatformListAPIModel[T]
+		if ok, _ := r.apiRequest(ctx, http.MethodGet, collectionPath+"?"+query.Encode(), nil, &page, diagnostics); !ok {
+			return nil, false
+		}
+		items = append(items, page.Items...)
+		if len(page.Items) < platformListPageSize || (page.Count > 0 && len(items) >= page.Count) {
+			return items, true
 		}
 	}
-	tflog.Debug(ctx, fmt.Sprintf("--> %s %s%s %s", method, r.Platform.BaseURL, path, bodyString))
-
-	var res *resty.Response
-	if err == nil {
-		req := r.Platform.R().
-			SetContext(ctx).
-			SetDoNotParseResponse(true)
+}
 
-		if isYaml {
-			req = req.SetHeader("Content-type", "application/x-yaml")
-		} else {
-			req = req.SetHeader("Content-type", "application/json")
-		}
-		if bodyBytes != nil {
-			req = req.SetBody(bodyBytes)
+// listResults returns the results of a list resource, for the objects listed from the platform. The
+// state of each result is built the same way as an import: from the parent attributes of the identity
+// (parents are the values of all but the last identity attribute, such as the environment and service
+// from the list config), with toData filling in the rest from the object. The identity and display
+// name of each result are then read from that state.
+func listResults[T any, M any](ctx context.Context, req list.ListRequest, identity resourceIdentity, parents []types.String, items []T, toData func(api *T, data *M, diagnostics *diag.Diagnostics)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			result := req.NewListResult(ctx)
+			state := *result.Resource
+			for j, parent := range parents {
+				result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(identity[j]), parent)...)
+			}
+			var data M
+			result.Diagnostics.Append(state.Get(ctx, &data)...)
+			if !result.Diagnostics.HasError() {
+				toData(&items[i], &data, &result.Diagnostics)
+				result.Diagnostics.Append(state.Set(ctx, &data)...)
+			}
+			values := make([]types.String, len(identity))
+			for j, attr := range identity {
+				result.Diagnostics.Append(state.GetAttribute(ctx, path.Root(attr), &values[j])...)
+			}
+			identity.set(ctx, result.Identity, &result.Diagnostics, values...)
+			var name types.String
+			result.Diagnostics.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+			result.DisplayName = name.ValueString()
+			if result.DisplayName == "" {
+				result.DisplayName = values[len(values)-1].ValueString()
+			}
+			if req.IncludeResource {
+				result.Resource = &state
+			}
+			if !push(result) {
+				return
+			}
 		}
-		res, err = req.Execute(method, path)
 	}
-	var statusString string
-	var rawBytes []byte
-	statusCode := -1
-	if err != nil {
-		statusString = err.Error()
-	} else {
-		statusCode = res.StatusCode()
-		statusString = fmt.Sprintf("%d %s", statusCode, res.Status())
+}
+
+// addListFilter adds a query parameter to filter a list on, if the attribute is set
+func addListFilter(filters url.Values, param string, value types.String) {
+	if value.ValueString() != "" {
+		filters.Set(param, value.ValueString())
 	}
-	tflog.Debug(ctx, fmt.Sprintf("<-- %s %s%s [%s]", method, r.Platform.BaseURL, path, statusString))
-	if res != nil && res.RawResponse != nil {
-		defer res.RawResponse.Body.Close()
-		rawBytes, err = io.ReadAll(res.RawBody())
+}
+
+// filterByNamePrefix keeps the listed objects whose name starts with prefix, where one is set. The
+// `name` query parameter of platform collections only matches whole names, so prefixes are matched
+// on the listed objects.
+func filterByNamePrefix[T any](items []T, prefix types.String, name func(*T) string) []T {
+	if prefix.ValueString() == "" {
+		return items
 	}
-	if rawBytes != nil {
-		tflog.Debug(ctx, fmt.Sprintf("Response: %s", rawBytes))
+	filtered := []T{}
+	for i := range items {
+		if strings.HasPrefix(name(&items[i]), prefix.ValueString()) {
+			filtered = append(filtered, items[i])
+		}
 	}
-	if err == nil && res.IsSuccess() && result != nil {
-		err = json.Unmarshal(rawBytes, &result)
+	return filtered
+}
+
+// lookupByNameOrID reads an object from the collection at collectionPath, directly when an ID is
+// configured, otherwise by listing the collection filtered by name. Looking up by name fails unless
+// exactly one object has that name.
+func lookupByNameOrID[T any](ctx context.Context, r *commonDataSource, collectionPath, kind string, id, name types.String, result *T, diagnostics *diag.Diagnostics) bool {
+	if id.ValueString() != "" {
+		ok, _ := r.apiRequest(ctx, http.MethodGet, collectionPath+"/"+url.PathEscape(id.ValueString()), nil, result, diagnostics)
+		return ok
 	}
-	ok := true
-	if err != nil {
-		ok = false
-		isCancelled := false
-		select {
-		case <-ctx.Done():
-			isCancelled = true
-		default:
+	return lookupByField(ctx, r, collectionPath, kind, "name", "name", name.ValueString(), result, diagnostics)
+}
+
+// lookupByField lists the collection at collectionPath filtered on a field, and reads the single
+// object with exactly that value. Errors are reported against the attribute the value was set from.
+func lookupByField[T any](ctx context.Context, r *commonDataSource, collectionPath, kind, attribute, apiField, value string, result *T, diagnostics *diag.Diagnostics) bool {
+	items, ok := listAll[map[string]json.RawMessage](ctx, r, collectionPath, url.Values{apiField: {value}}, diagnostics)
+	if !ok {
+		return false
+	}
+	var matches []map[string]json.RawMessage
+	for _, item := range items {
+		// Filters match whole values, but a collection that does not support filtering on the field
+		// returns every object, so only keep the objects with exactly that value
+		var fieldValue string
+		if err := json.Unmarshal(item[apiField], &fieldValue); err == nil && fieldValue == value {
+			matches = append(matches, item)
 		}
-		errorInfo := fmt.Sprintf("%s %s failed with error: %s", method, path, err)
-		if isCancelled {
-			for _, o := range options {
-				if o.CancelInfo != "" {
-					errorInfo = fmt.Sprintf("%s %s", errorInfo, o.CancelInfo)
-				}
-			}
-			diagnostics.AddError(
-				fmt.Sprintf("%s cancelled", method),
-				errorInfo,
-			)
-		} else {
-			diagnostics.AddError(
-				fmt.Sprintf("%s failed", method),
-				errorInfo,
-			)
+	}
+	switch len(matches) {
+	case 0:
+		diagnostics.AddAttributeError(path.Root(attribute), fmt.Sprintf("%s not found", kind),
+			fmt.Sprintf("No %s with %s '%s' was found in %s", kind, attribute, value, collectionPath))
+		return false
+	case 1:
+		b, err := json.Marshal(matches[0])
+		if err == nil {
+			err = json.Unmarshal(b, result)
 		}
-	} else if !res.IsSuccess() {
-		isOk404 := false
-		if statusCode == 404 {
-			for _, o := range options {
-				if o.allow404 {
-					isOk404 = true
-				}
-			}
+		if err != nil {
+			diagnostics.AddError(fmt.Sprintf("failed to parse %s", kind), err.Error())
+			return false
 		}
-		if !isOk404 {
-			ok = false
-			errorInfo := fmt.Sprintf("%s %s returned status code %d: %s", method, path, statusCode, rawBytes)
-			diagnostics.AddError(
-				fmt.Sprintf("%s failed", method),
-				errorInfo,
-			)
+		return true
+	default:
+		diagnostics.AddAttributeError(path.Root(attribute), fmt.Sprintf("multiple %ss found", kind),
+			fmt.Sprintf("%d objects with %s '%s' were found in %s. Look up the %s by id instead.", len(matches), attribute, value, collectionPath, kind))
+		return false
+	}
+}
+
+// Defaults for the timeouts block on resources with long-running operations
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 30 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+)
+
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// waitTimedOut is true once the deadline from the timeouts block has passed, as opposed
+// to Terraform itself cancelling the operation
+func waitTimedOut(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded)
+}
+
+func addWaitTimeoutError(path, status, lastStatus string, diagnostics *diag.Diagnostics) {
+	detail := fmt.Sprintf("%s did not reach status '%s' before the timeout expired", path, status)
+	if lastStatus != "" {
+		detail = fmt.Sprintf("%s (last status: %s)", detail, lastStatus)
+	}
+	diagnostics.AddError(
+		fmt.Sprintf("timed out waiting for status %s", status),
+		fmt.Sprintf("%s. The timeout can be increased using the timeouts block on the resource.", detail),
+	)
+}
+
+// waitForStatus polls until check reports the operation is complete, replacing the errors from a
+// request cancelled by the timeouts block deadline with a single timeout error
+func (r *commonResource) waitForStatus(ctx context.Context, logDescription, path, status string, diagnostics *diag.Diagnostics, check func(attemptDiags *diag.Diagnostics) (lastStatus string, retry bool, err error)) {
+	lastStatus := ""
+	err := r.retry().Do(ctx, logDescription, func(attempt int) (retry bool, err error) {
+		var attemptDiags diag.Diagnostics
+		var s string
+		s, retry, err = check(&attemptDiags)
+		if waitTimedOut(ctx) {
+			return false, fmt.Errorf("timed out")
+		}
+		diagnostics.Append(attemptDiags...)
+		if s != "" {
+			lastStatus = s
 		}
+		return retry, err
+	})
+	if err != nil && waitTimedOut(ctx) {
+		addWaitTimeoutError(path, status, lastStatus, diagnostics)
+	} else if err != nil && ctx.Err() == nil && !diagnostics.HasError() {
+		// retry_max_attempts was reached
+		diagnostics.AddError(fmt.Sprintf("gave up waiting for status %s", status), fmt.Sprintf("%s: %s", path, err))
 	}
-	return ok, statusCode
 }
 
 func (r *commonResource) waitForReadyStatus(ctx context.Context, path string, diagnostics *diag.Diagnostics) {
@@ -313,20 +571,20 @@ func (r *commonResource) waitForReadyStatus(ctx context.Context, path string, di
 		Status string `json:"status"`
 	}
 	cancelInfo := APICancelInfo()
-	_ = kaleidobase.Retry.Do(ctx, fmt.Sprintf("ready-check %s", path), func(attempt int) (retry bool, err error) {
+	r.waitForStatus(ctx, fmt.Sprintf("ready-check %s", path), path, "ready", diagnostics, func(attemptDiags *diag.Diagnostics) (string, bool, error) {
 		var res statusResponse
-		ok, statusCode := r.apiRequest(ctx, http.MethodGet, path, nil, &res, diagnostics, cancelInfo)
+		ok, statusCode := r.apiRequest(ctx, http.MethodGet, path, nil, &res, attemptDiags, cancelInfo)
 		if !ok {
 			if statusCode == 429 {
-				return true, fmt.Errorf("rate limit exceeded")
+				return "", true, fmt.Errorf("rate limit exceeded")
 			}
-			return false, fmt.Errorf("ready-check failed") // already set in diag
+			return "", false, fmt.Errorf("ready-check failed") // already set in diag
 		}
 		cancelInfo.CancelInfo = fmt.Sprintf("(waiting for ready - status: %s)", res.Status)
 		if !strings.EqualFold(res.Status, "ready") {
-			return true, fmt.Errorf("not ready yet")
+			return res.Status, true, fmt.Errorf("not ready yet")
 		}
-		return false, nil
+		return res.Status, false, nil
 	})
 }
 
@@ -336,25 +594,138 @@ func (r *commonResource) waitForRemoval(ctx context.Context, path string, diagno
 	}
 	cancelInfo := APICancelInfo()
 	cancelInfo.CancelInfo = "(waiting for removal)"
-	_ = kaleidobase.Retry.Do(ctx, fmt.Sprintf("ready-check %s", path), func(attempt int) (retry bool, err error) {
+	r.waitForStatus(ctx, fmt.Sprintf("ready-check %s", path), path, "deleted", diagnostics, func(attemptDiags *diag.Diagnostics) (string, bool, error) {
 		var res statusResponse
-		ok, status := r.apiRequest(ctx, http.MethodGet, path, nil, &res, diagnostics, Allow404(), cancelInfo)
+		ok, status := r.apiRequest(ctx, http.MethodGet, path, nil, &res, attemptDiags, Allow404(), cancelInfo)
 		if !ok {
-			return false, fmt.Errorf("ready-check failed") // already set in diag
+			return "", false, fmt.Errorf("ready-check failed") // already set in diag
 		}
 		if status != 404 {
-			return true, fmt.Errorf("not removed yet")
+			return res.Status, true, fmt.Errorf("not removed yet")
 		}
-		return false, nil
+		return "", false, nil
 	})
 }
 
+// configJSONFromAPI rebuilds config_json from the config returned by the API, so that changes
+// made outside of Terraform show up as drift. The platform merges defaults into the config it
+// stores, so only the keys in the prior value are kept. With no prior value (such as after an
+// import) the full config is returned.
+func configJSONFromAPI(prior jsonStringVal, config map[string]interface{}, diagnostics *diag.Diagnostics) jsonStringVal {
+	var actual interface{} = config
+	if config == nil {
+		actual = map[string]interface{}{}
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var configured interface{}
+		if err := json.Unmarshal([]byte(prior.ValueString()), &configured); err != nil {
+			return prior
+		}
+		actual = projectConfiguredJSON(configured, actual)
+	}
+	b, err := json.Marshal(actual)
+	if err != nil {
+		diagnostics.AddError("failed to serialize config_json", err.Error())
+		return prior
+	}
+	return newJSONNullStrippedString(string(b))
+}
+
+// projectConfiguredJSON returns the parts of actual that were configured, recursing into nested
+// objects, and into arrays of the same length. Keys configured as null are left out, so that a
+// default the server fills in for them is not reported as drift.
+func projectConfiguredJSON(configured, actual interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		out := make(map[string]interface{}, len(c))
+		for k, cv := range c {
+			if av, ok := a[k]; ok && cv != nil {
+				out[k] = projectConfiguredJSON(cv, av)
+			}
+		}
+		return out
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(c) {
+			return actual
+		}
+		out := make([]interface{}, len(a))
+		for i := range a {
+			out[i] = projectConfiguredJSON(c[i], a[i])
+		}
+		return out
+	default:
+		return actual
+	}
+}
+
 func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		EVMNetInfoDataSourceFactory,
 		NetworkBootstrapDatasourceModelFactory,
 		AccountDatasourceModelFactory,
 		PaladinEVMRegistryDatasourceModelFactory,
+		EnvironmentDatasourceModelFactory,
+		RuntimeDatasourceModelFactory,
+		ServiceDatasourceModelFactory,
+		NetworkDatasourceModelFactory,
+		ServicesDatasourceModelFactory,
+		RuntimesDatasourceModelFactory,
+		NetworksDatasourceModelFactory,
+		StacksDatasourceModelFactory,
+		KMSWalletDatasourceModelFactory,
+		KMSKeyDatasourceModelFactory,
+		CMSBuildDatasourceModelFactory,
+		EVMCallDatasourceModelFactory,
+		EVMReceiptDatasourceModelFactory,
+		FireFlyStatusDatasourceModelFactory,
+		UserDatasourceModelFactory,
+		GroupDatasourceModelFactory,
+		ApplicationDatasourceModelFactory,
+		PMSIdentityDatasourceModelFactory,
+		PMSPolicyDeploymentDatasourceModelFactory,
+		WMSAssetDatasourceModelFactory,
+		WMSWalletDatasourceModelFactory,
+		WMSAccountDatasourceModelFactory,
+	}
+}
+
+func EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		APIKeyEphemeralResourceFactory,
+		AccessTokenEphemeralResourceFactory,
+	}
+}
+
+func Functions() []func() function.Function {
+	return []func() function.Function{
+		ParseKMSURIFunctionFactory,
+		EVMChecksumAddressFunctionFactory,
+		ABIEncodeConstructorFunctionFactory,
+		ABIFunctionSelectorFunctionFactory,
+		Secp256k1AddressFromPubkeyFunctionFactory,
+	}
+}
+
+func Actions() []func() action.Action {
+	return []func() action.Action{
+		RuntimeRestartActionFactory,
+	}
+}
+
+func ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		ServiceListResourceFactory,
+		RuntimeListResourceFactory,
+		NetworkListResourceFactory,
+		KMSWalletListResourceFactory,
+		KMSKeyListResourceFactory,
+		AMSTaskListResourceFactory,
+		WFEWorkflowListResourceFactory,
 	}
 }
 
@@ -452,3 +823,110 @@ type CredSetBasicAuthAPI struct {
 type CredSetKeyAPI struct {
 	Value string `json:"value,omitempty"`
 }
+
+// credSetsWriteOnlyAttribute is the write-only variant of cred_sets, whose secrets are sent to the
+// API without being stored in state. Every attribute nested in a write-only attribute must also be
+// write-only.
+func credSetsWriteOnlyAttribute() *schema.MapNestedAttribute {
+	return &schema.MapNestedAttribute{
+		Description: "Write-only alternative to `cred_sets`, that is never stored in state. Requires Terraform 1.11 or later. The credentials are sent whenever the resource is created or updated, so change `cred_sets_wo_version` to update them.",
+		Optional:    true,
+		WriteOnly:   true,
+		Validators:  []validator.Map{mapvalidator.ConflictsWith(path.MatchRoot("cred_sets"))},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": &schema.StringAttribute{
+					Required:  true,
+					WriteOnly: true,
+				},
+				"basic_auth": &schema.SingleNestedAttribute{
+					Optional:  true,
+					WriteOnly: true,
+					Attributes: map[string]schema.Attribute{
+						"username": &schema.StringAttribute{
+							Required:  true,
+							WriteOnly: true,
+						},
+						"password": &schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+							WriteOnly: true,
+						},
+					},
+				},
+				"key": &schema.SingleNestedAttribute{
+					Optional:  true,
+					WriteOnly: true,
+					Attributes: map[string]schema.Attribute{
+						"value": &schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+							WriteOnly: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// writeOnlyVersionAttribute triggers an update when a write-only value changes, as Terraform does
+// not store write-only values to compare against
+func writeOnlyVersionAttribute(writeOnlyAttribute string) *schema.Int64Attribute {
+	return &schema.Int64Attribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Version of `%s`. Terraform cannot detect changes to write-only values, so change this to update the resource with a new value of `%s`.", writeOnlyAttribute, writeOnlyAttribute),
+	}
+}
+
+// credSetsFromConfig sets the cred sets sent to the API from cred_sets_wo, if configured. Write-only
+// values are only available in the config, and are null in the plan and state.
+func credSetsFromConfig(ctx context.Context, config tfsdk.Config, apiCredsets *map[string]*CredSetAPI, diagnostics *diag.Diagnostics) {
+	var credsets types.Map
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("cred_sets_wo"), &credsets)...)
+	if !credsets.IsNull() && !credsets.IsUnknown() {
+		*apiCredsets = credSetsToAPI(ctx, credsets, diagnostics)
+	}
+}
+
+// credSetsToAPI converts the cred_sets (or cred_sets_wo) of a service or network to the API model
+func credSetsToAPI(ctx context.Context, credsets types.Map, diagnostics *diag.Diagnostics) map[string]*CredSetAPI {
+	basicAuthAttrs := map[string]attr.Type{
+		"username": types.StringType,
+		"password": types.StringType,
+	}
+	keyAttrs := map[string]attr.Type{
+		"value": types.StringType,
+	}
+	apiCredsets := make(map[string]*CredSetAPI)
+	for credSetName, tfCredSetAttr := range credsets.Elements() {
+		tfCredSet := tfCredSetAttr.(types.Object)
+		tfCredSetAttrs := tfCredSet.Attributes()
+		crType := tfCredSetAttrs["type"].(types.String).ValueString()
+		cr := &CredSetAPI{
+			Name: credSetName,
+			Type: crType,
+		}
+		if crType == "basic_auth" && !tfCredSetAttrs["basic_auth"].IsNull() {
+			tfBasicAuth, d := types.ObjectValueFrom(ctx, basicAuthAttrs, tfCredSetAttrs["basic_auth"])
+			diagnostics.Append(d...)
+			tfBasicAuthAttrs := tfBasicAuth.Attributes()
+			cr.BasicAuth = &CredSetBasicAuthAPI{
+				Username: tfBasicAuthAttrs["username"].(types.String).ValueString(),
+				Password: tfBasicAuthAttrs["password"].(types.String).ValueString(),
+			}
+		} else if crType == "key" && !tfCredSetAttrs["key"].IsNull() {
+			tfKey, d := types.ObjectValueFrom(ctx, keyAttrs, tfCredSetAttrs["key"])
+			diagnostics.Append(d...)
+			tfKeyAttrs := tfKey.Attributes()
+			cr.Key = &CredSetKeyAPI{
+				Value: tfKeyAttrs["value"].(types.String).ValueString(),
+			}
+		} else {
+			diagnostics.AddError("missing credential", fmt.Sprintf("must specify key/basic_auth as appropriate for type '%s'", crType))
+			return nil
+		}
+		apiCredsets[credSetName] = cr
+	}
+	return apiCredsets
+}
@@ -14,12 +14,28 @@
 package platform
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
@@ -43,6 +59,10 @@ func init() {
 		"0.0.1-unittest",
 		Resources(),
 		DataSources(),
+		EphemeralResources(),
+		Functions(),
+		Actions(),
+		ListResources(),
 	)
 	testAccProviders = map[string]func() (tfprotov6.ProviderServer, error){
 		"kaleido": providerserver.NewProtocol6WithError(kaleidoProvider),
@@ -63,3 +83,471 @@ func testYAMLEqual(t *testing.T, obj interface{}, expected string) {
 	assert.NoError(t, err)
 	assert.YAMLEq(t, expected, string(yamlObj))
 }
+
+// testRunFunction runs a provider-defined function with string arguments, returning the result
+func testRunFunction(t *testing.T, f function.Function, args ...string) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+	var defResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &defResp)
+	result, funcErr := defResp.Definition.Return.NewResultData(ctx)
+	assert.Nil(t, funcErr)
+	argValues := make([]attr.Value, len(args))
+	for i, arg := range args {
+		argValues[i] = types.StringValue(arg)
+	}
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(argValues)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// testInvokeAction configures and invokes an action, with the given attributes set in its config,
+// returning the progress messages it sent
+func testInvokeAction(t *testing.T, a action.Action, pd *kaleidobase.ProviderData, attrs map[string]tftypes.Value) ([]string, diag.Diagnostics) {
+	ctx := context.Background()
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	values := make(map[string]tftypes.Value)
+	for name, attribute := range s.Attributes {
+		values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		}
+	}
+
+	var configureResp action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: pd}, &configureResp)
+	assert.False(t, configureResp.Diagnostics.HasError())
+
+	var progress []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), values),
+		},
+	}, &resp)
+	return progress, resp.Diagnostics
+}
+
+// testListResource configures and runs a list resource, with the given attributes set in its config,
+// returning every result it streams
+func testListResource(t *testing.T, l list.ListResource, pd *kaleidobase.ProviderData, attrs map[string]tftypes.Value, includeResource bool, limit int64) []list.ListResult {
+	ctx := context.Background()
+	var schemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	values := make(map[string]tftypes.Value)
+	for name, attribute := range s.Attributes {
+		values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		}
+	}
+
+	var resourceSchemaResp resource.SchemaResponse
+	l.(resource.Resource).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	l.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	var configureResp resource.ConfigureResponse
+	l.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: pd}, &configureResp)
+	assert.False(t, configureResp.Diagnostics.HasError())
+
+	var stream list.ListResultsStream
+	l.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), values),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}, &stream)
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+// testListResultAttrs reads the string attributes of a list result identity, or of its resource
+func testListResultAttrs(t *testing.T, getter interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, attrs ...string) map[string]string {
+	values := make(map[string]string)
+	for _, name := range attrs {
+		var value attr.Value
+		diags := getter.GetAttribute(context.Background(), path.Root(name), &value)
+		assert.False(t, diags.HasError(), diags)
+		stringValue, diags := value.(basetypes.StringValuable).ToStringValue(context.Background())
+		assert.False(t, diags.HasError(), diags)
+		values[name] = stringValue.ValueString()
+	}
+	return values
+}
+
+func testImportStateFromID(id string, attrs ...string) *resource.ImportStateResponse {
+	return testImportState(resource.ImportStateRequest{ID: id}, attrs...)
+}
+
+func testImportState(req resource.ImportStateRequest, attrs ...string) *resource.ImportStateResponse {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{Required: true},
+			"service":     &schema.StringAttribute{Required: true},
+			"wallet":      &schema.StringAttribute{Required: true},
+			"id":          &schema.StringAttribute{Computed: true},
+		},
+	}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	importStateFromID(ctx, req, resp, attrs...)
+	return resp
+}
+
+func TestImportStateFromID(t *testing.T) {
+	resp := testImportStateFromID("env1/service1/wallet1/key1", "environment", "service", "wallet", "id")
+	assert.False(t, resp.Diagnostics.HasError())
+	for attr, expected := range map[string]string{
+		"environment": "env1",
+		"service":     "service1",
+		"wallet":      "wallet1",
+		"id":          "key1",
+	} {
+		var value string
+		resp.State.GetAttribute(context.Background(), path.Root(attr), &value)
+		assert.Equal(t, expected, value)
+	}
+
+	resp = testImportStateFromID("key1", "id")
+	assert.False(t, resp.Diagnostics.HasError())
+	var id string
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	assert.Equal(t, "key1", id)
+}
+
+func TestImportStateFromIdentity(t *testing.T) {
+	ctx := context.Background()
+	identity := resourceIdentity{"environment", "service", "wallet", "id"}
+	identitySchema := identity.schema()
+	req := resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	var diags diag.Diagnostics
+	identity.set(ctx, req.Identity, &diags, types.StringValue("env1"), types.StringValue("service1"), types.StringValue("wallet1"), types.StringValue("key1"))
+	assert.False(t, diags.HasError())
+
+	resp := testImportState(req, identity...)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, map[string]string{
+		"environment": "env1",
+		"service":     "service1",
+		"wallet":      "wallet1",
+		"id":          "key1",
+	}, testListResultAttrs(t, resp.State, identity...))
+}
+
+type importableResource interface {
+	resource.ResourceWithImportState
+	resource.ResourceWithIdentity
+}
+
+// testIdentity returns an identity for the resource, with the given values set in the order of
+// its identity attributes, or a null identity when there are none
+func testIdentity(t *testing.T, r importableResource, identity resourceIdentity, values ...string) *tfsdk.ResourceIdentity {
+	ctx := context.Background()
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	ri := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+	if len(values) > 0 {
+		var diags diag.Diagnostics
+		stringValues := make([]types.String, len(values))
+		for i, v := range values {
+			stringValues[i] = types.StringValue(v)
+		}
+		identity.set(ctx, ri, &diags, stringValues...)
+		assert.False(t, diags.HasError(), diags)
+	}
+	return ri
+}
+
+// testImportAndRead imports a resource the way Terraform does, then reads it back as the refresh
+// that follows the import
+func testImportAndRead(t *testing.T, r importableResource, identity resourceIdentity, req resource.ImportStateRequest) *resource.ReadResponse {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: testIdentity(t, r, identity),
+	}
+	if req.Identity != nil {
+		importResp.Identity.Raw = req.Identity.Raw.Copy()
+	}
+	r.ImportState(ctx, req, importResp)
+	assert.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	return readResp
+}
+
+func TestImportByNameRefreshIdentity(t *testing.T) {
+	ctx := context.Background()
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	// The platform resolves the runtime by name or ID in the path
+	rt := &RuntimeAPIModel{ID: "rt1_id", Name: "runtime1", Type: "besu", Status: "ready"}
+	mp.runtimes["env1/runtime1"] = rt
+	mp.runtimes["env1/rt1_id"] = rt
+	r := &runtimeResource{commonResource: commonResource{ProviderData: &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}}}
+
+	// The refresh after the import resolves the name to the ID, in both the state and the identity
+	readResp := testImportAndRead(t, r, runtimeIdentity, resource.ImportStateRequest{ID: "env1/runtime1"})
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "rt1_id"}, testListResultAttrs(t, readResp.State, "environment", "id"))
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "rt1_id"}, testListResultAttrs(t, readResp.Identity, "environment", "id"))
+
+	// So later refreshes see no change to the identity
+	identity := testIdentity(t, r, runtimeIdentity)
+	identity.Raw = readResp.Identity.Raw.Copy()
+	nextResp := &resource.ReadResponse{State: readResp.State, Identity: identity}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State, Identity: readResp.Identity}, nextResp)
+	assert.False(t, nextResp.Diagnostics.HasError(), nextResp.Diagnostics)
+	assert.True(t, nextResp.Identity.Raw.Equal(readResp.Identity.Raw))
+
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+	})
+}
+
+func TestImportByIdentityRead(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.services["env1/svc1"] = &ServiceAPIModel{ID: "svc1", Name: "node1", Type: "BesuNode", StackID: "stack1", Status: "ready",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt1"}, Config: map[string]interface{}{"setting1": "value1"}}
+	mp.amsTasks["env1/service1/task1_id"] = &AMSTaskAPIModel{ID: "task1_id", Name: "erc20-indexer", Description: "Index ERC-20 transfers", CurrentVersion: "v2", VariableSet: "vars1"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	// Importing from the identity of a list result reads back every attribute needed to plan no changes
+	svc := &serviceResource{commonResource: commonResource{ProviderData: pd}}
+	readResp := testImportAndRead(t, svc, serviceIdentity, resource.ImportStateRequest{
+		Identity: testIdentity(t, svc, serviceIdentity, "env1", "svc1"),
+	})
+	assert.Equal(t, map[string]string{
+		"environment": "env1",
+		"id":          "svc1",
+		"runtime":     "rt1",
+		"type":        "BesuNode",
+		"name":        "node1",
+		"stack_id":    "stack1",
+		"config_json": `{"setting1":"value1"}`,
+	}, testListResultAttrs(t, readResp.State, "environment", "id", "runtime", "type", "name", "stack_id", "config_json"))
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "svc1"}, testListResultAttrs(t, readResp.Identity, "environment", "id"))
+
+	task := &ams_taskResource{commonResource: commonResource{ProviderData: pd}}
+	readResp = testImportAndRead(t, task, amsTaskIdentity, resource.ImportStateRequest{
+		Identity: testIdentity(t, task, amsTaskIdentity, "env1", "service1", "task1_id"),
+	})
+	assert.Equal(t, map[string]string{
+		"environment":     "env1",
+		"service":         "service1",
+		"id":              "task1_id",
+		"name":            "erc20-indexer",
+		"description":     "Index ERC-20 transfers",
+		"variable_set":    "vars1",
+		"applied_version": "v2",
+	}, testListResultAttrs(t, readResp.State, "environment", "service", "id", "name", "description", "variable_set", "applied_version"))
+
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/services/{service}",
+		"GET /endpoint/{env}/{service}/rest/api/v1/tasks/{task}",
+	})
+}
+
+func TestImportStateFromIDBadShape(t *testing.T) {
+	resp := testImportStateFromID("key1", "environment", "service", "id")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid import ID", resp.Diagnostics[0].Summary())
+	assert.Equal(t, "Expected an import ID of the form 'environment/service/id', got: 'key1'", resp.Diagnostics[0].Detail())
+
+	resp = testImportStateFromID("env1/service1/key1", "id")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Expected an import ID of the form 'id', got: 'env1/service1/key1'", resp.Diagnostics[0].Detail())
+
+	resp = testImportStateFromID("env1//key1", "environment", "service", "id")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Expected an import ID of the form 'environment/service/id', got: 'env1//key1' (the 'service' segment is empty)", resp.Diagnostics[0].Detail())
+}
+
+func TestConfigJSONFromAPI(t *testing.T) {
+	config := map[string]interface{}{
+		"setting1":      "value1",
+		"serverDefault": "injected",
+		"nested": map[string]interface{}{
+			"setting2":      float64(2),
+			"nestedDefault": true,
+		},
+		"list": []interface{}{
+			map[string]interface{}{"a": "b", "listDefault": "c"},
+		},
+		"nulled": "filled-in",
+	}
+
+	var diags diag.Diagnostics
+	prior := newJSONNullStrippedString(`{"setting1":"value1","nested":{"setting2":2},"list":[{"a":"b"}],"nulled":null,"removed":"gone"}`)
+	v := configJSONFromAPI(prior, config, &diags)
+	assert.False(t, diags.HasError())
+	assert.JSONEq(t, `{"setting1":"value1","nested":{"setting2":2},"list":[{"a":"b"}]}`, v.ValueString())
+
+	// Semantically equal to the prior value, apart from the removed key
+	equal, _ := v.StringSemanticEquals(context.Background(), newJSONNullStrippedString(`{"setting1":"value1","nested":{"setting2":2},"list":[{"a":"b"}],"nulled":null}`))
+	assert.True(t, equal)
+
+	// No prior value on import, so the full config is used
+	v = configJSONFromAPI(jsonStringNull(), config, &diags)
+	assert.False(t, diags.HasError())
+	testJSONEqual(t, json.RawMessage(v.ValueString()), `{
+		"setting1": "value1",
+		"serverDefault": "injected",
+		"nested": {"setting2": 2, "nestedDefault": true},
+		"list": [{"a": "b", "listDefault": "c"}],
+		"nulled": "filled-in"
+	}`)
+
+	v = configJSONFromAPI(jsonStringNull(), nil, &diags)
+	assert.Equal(t, `{}`, v.ValueString())
+}
+
+func TestAPIErrorDiagnosticAttributePath(t *testing.T) {
+	apiErr := newAPIError(http.MethodPut, "/api/v1/environments/env1/runtimes/rt1", 400, http.Header{},
+		[]byte(`{"error":"KA010425: unsupported config setting","field":"config.nodes[0].setting","requestId":"req1"}`))
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostic(context.Background(), apiErr, &diags, ErrorFieldPath("config", path.Root("config_json")))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "PUT failed: unsupported config setting", diags[0].Summary())
+	assert.Equal(t, "PUT /api/v1/environments/env1/runtimes/rt1 returned status code 400: unsupported config setting\n"+
+		"Error code: KA010425\nField: config.nodes[0].setting\nRequest ID: req1", diags[0].Detail())
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	assert.True(t, ok)
+	assert.Equal(t, path.Root("config_json"), withPath.Path())
+
+	// Without an override, the field name is converted to the attribute name
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"storage_size": schema.StringAttribute{Optional: true},
+	}}
+	diags = nil
+	apiErr.PlatformError.Field = "/storageSize"
+	addAPIErrorDiagnostic(context.Background(), apiErr, &diags, ErrorAttributes(s))
+	assert.Equal(t, path.Root("storage_size"), diags[0].(diag.DiagnosticWithPath).Path())
+
+	// An unmapped field with no matching attribute in the schema is reported without a path
+	diags = nil
+	apiErr.PlatformError.Field = "/environmentMemberId"
+	addAPIErrorDiagnostic(context.Background(), apiErr, &diags, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(s))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "PUT failed: unsupported config setting", diags[0].Summary())
+	_, ok = diags[0].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+
+	// As is an unmapped field when no schema is passed
+	diags = nil
+	apiErr.PlatformError.Field = "/storageSize"
+	addAPIErrorDiagnostic(context.Background(), apiErr, &diags)
+	_, ok = diags[0].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+}
+
+func TestAPIErrorDiagnosticUnstructured(t *testing.T) {
+	apiErr := newAPIError(http.MethodGet, "/api/v1/things", 500, http.Header{"X-Request-Id": []string{"req2"}}, []byte(`upstream failure`))
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostic(context.Background(), apiErr, &diags)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "GET failed", diags[0].Summary())
+	assert.Equal(t, "GET /api/v1/things returned status code 500: upstream failure\nRequest ID: req2", diags[0].Detail())
+	_, withPath := diags[0].(diag.DiagnosticWithPath)
+	assert.False(t, withPath)
+}
+
+func TestLookupByNameOrID(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.services["env1/svc1"] = &ServiceAPIModel{ID: "svc1", Name: "service1"}
+	mp.services["env1/svc2"] = &ServiceAPIModel{ID: "svc2", Name: "service10"}
+	mp.services["env1/svc3"] = &ServiceAPIModel{ID: "svc3", Name: "service2"}
+	mp.services["env1/svc4"] = &ServiceAPIModel{ID: "svc4", Name: "service2"}
+	r := &commonDataSource{ProviderData: &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}}
+	ctx := context.Background()
+	collectionPath := "/api/v1/environments/env1/services"
+
+	var diags diag.Diagnostics
+	var api ServiceAPIModel
+	ok := lookupByNameOrID(ctx, r, collectionPath, "service", types.StringNull(), types.StringValue("service1"), &api, &diags)
+	assert.True(t, ok)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "svc1", api.ID)
+
+	api = ServiceAPIModel{}
+	ok = lookupByNameOrID(ctx, r, collectionPath, "service", types.StringValue("svc2"), types.StringNull(), &api, &diags)
+	assert.True(t, ok)
+	assert.Equal(t, "service10", api.Name)
+
+	ok = lookupByNameOrID(ctx, r, collectionPath, "service", types.StringNull(), types.StringValue("service3"), &api, &diags)
+	assert.False(t, ok)
+	assert.Equal(t, "service not found", diags[0].Summary())
+	assert.Equal(t, path.Root("name"), diags[0].(diag.DiagnosticWithPath).Path())
+
+	diags = nil
+	ok = lookupByNameOrID(ctx, r, collectionPath, "service", types.StringNull(), types.StringValue("service2"), &api, &diags)
+	assert.False(t, ok)
+	assert.Equal(t, "multiple services found", diags[0].Summary())
+}
+
+func TestListAllPages(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	for i := 0; i < 250; i++ {
+		id := fmt.Sprintf("svc%03d", i)
+		mp.services["env1/"+id] = &ServiceAPIModel{ID: id, Name: id, Type: "BesuNode"}
+	}
+	mp.services["env1/other"] = &ServiceAPIModel{ID: "other", Name: "other", Type: "EVMGateway"}
+	r := &commonDataSource{ProviderData: &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}}
+
+	var diags diag.Diagnostics
+	services, ok := listAll[ServiceAPIModel](context.Background(), r, "/api/v1/environments/env1/services", url.Values{"type": {"BesuNode"}}, &diags)
+	assert.True(t, ok)
+	assert.Len(t, services, 250)
+	assert.Equal(t, "svc249", services[249].ID)
+	assert.Len(t, mp.calls, 3)
+}
@@ -170,3 +170,7 @@ func (r *connectorConfigTypeResource) Delete(_ context.Context, _ resource.Delet
 	// The connector-manager does not currently expose a delete endpoint; removing this
 	// resource from state is a no-op. See .ai/plan.md for the open verification item.
 }
+
+func (r *connectorConfigTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "name")
+}
@@ -354,3 +354,7 @@ func (r *connectorCustomAPIResource) Delete(ctx context.Context, req resource.De
 		}
 	}
 }
+
+func (r *connectorCustomAPIResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service_id", "name")
+}
@@ -235,3 +235,7 @@ func (r *connectorFlowResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 	r.apiRequest(ctx, http.MethodDelete, r.instancePath(&data), nil, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *connectorFlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "name")
+}
@@ -320,3 +320,7 @@ func (r *connectorFlowConfigBindingResource) Delete(ctx context.Context, req res
 	patch := ConnectorFlowConfigBindingPatchAPIModel{ConfigProfileID: nil, DynamicMapping: nil}
 	r.apiRequest(ctx, http.MethodPatch, r.instancePath(&data), &patch, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *connectorFlowConfigBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "flow", "id")
+}
@@ -202,3 +202,7 @@ func (r *connectorStandardAPIResource) Delete(ctx context.Context, req resource.
 	}
 	r.apiRequest(ctx, http.MethodDelete, r.instancePath(&data), nil, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *connectorStandardAPIResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "name")
+}
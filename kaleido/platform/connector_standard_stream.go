@@ -181,3 +181,7 @@ func (r *connectorStandardStreamResource) Delete(ctx context.Context, req resour
 	}
 	r.apiRequest(ctx, http.MethodDelete, r.instancePath(&data), nil, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *connectorStandardStreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "name")
+}
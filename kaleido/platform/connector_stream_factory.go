@@ -160,3 +160,7 @@ func (r *connectorStreamFactoryResource) Delete(ctx context.Context, req resourc
 	}
 	r.apiRequest(ctx, http.MethodDelete, r.instancePath(&data), nil, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *connectorStreamFactoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "name")
+}
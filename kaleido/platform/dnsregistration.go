@@ -199,5 +199,5 @@ func (r *dnsRegistrationResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *dnsRegistrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// TODO
+	importStateFromID(ctx, req, resp, "environment", "id")
 }
@@ -0,0 +1,98 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EnvironmentDatasourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Version        types.String `tfsdk:"version"`
+	UpdateStrategy types.String `tfsdk:"update_strategy"`
+}
+
+func (api *EnvironmentAPIModel) toDatasourceData(data *EnvironmentDatasourceModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Version = types.StringValue(api.Version)
+	data.UpdateStrategy = types.StringValue(api.UpdateStrategy)
+}
+
+func EnvironmentDatasourceModelFactory() datasource.DataSource {
+	return &environmentDatasource{}
+}
+
+type environmentDatasource struct {
+	commonDataSource
+}
+
+func (r *environmentDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_environment"
+}
+
+func (r *environmentDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing environment by name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Environment Name. Exactly one of `id` or `name` must be set",
+			},
+			"version": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Environment Version",
+			},
+			"update_strategy": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Update Strategy (manual or automatic)",
+			},
+		},
+	}
+}
+
+func (r *environmentDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *environmentDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api EnvironmentAPIModel
+	if !lookupByNameOrID(ctx, &r.commonDataSource, "/api/v1/environments", "environment", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,61 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var environmentDataStep1 = `
+data "kaleido_platform_environment" "environment1" {
+    name = "environment1"
+}
+`
+
+func TestEnvironmentData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.environments["env1"] = &EnvironmentAPIModel{ID: "env1", Name: "environment1", Version: "1.0.0", UpdateStrategy: "manual"}
+	mp.environments["env2"] = &EnvironmentAPIModel{ID: "env2", Name: "environment10"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments",
+			"GET /api/v1/environments",
+			"GET /api/v1/environments",
+		})
+		mp.server.Close()
+	}()
+
+	environmentData := "data.kaleido_platform_environment.environment1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + environmentDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(environmentData, "id", "env1"),
+					resource.TestCheckResourceAttr(environmentData, "version", "1.0.0"),
+					resource.TestCheckResourceAttr(environmentData, "update_strategy", "manual"),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listEnvironments(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.environments, "")
+}
@@ -0,0 +1,259 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hyperledger/firefly-signer/pkg/abi"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+)
+
+type EVMCallDatasourceModel struct {
+	Environment types.String  `tfsdk:"environment"`
+	Service     types.String  `tfsdk:"service"`
+	JsonRpcURL  types.String  `tfsdk:"json_rpc_url"`
+	Username    types.String  `tfsdk:"username"`
+	Password    types.String  `tfsdk:"password"`
+	Address     types.String  `tfsdk:"address"`
+	ABI         types.String  `tfsdk:"abi"`
+	Method      types.String  `tfsdk:"method"`
+	ParamsJSON  jsonStringVal `tfsdk:"params_json"`
+	From        types.String  `tfsdk:"from"`
+	Block       types.String  `tfsdk:"block"`
+	Output      types.String  `tfsdk:"output"`
+	OutputsJSON jsonStringVal `tfsdk:"outputs_json"`
+}
+
+type EVMCallTransactionAPIModel struct {
+	From string                    `json:"from,omitempty"`
+	To   string                    `json:"to"`
+	Data ethtypes.HexBytes0xPrefix `json:"data"`
+}
+
+// abiSerializer formats decoded values the same way as FireFly, with integers as base 10 strings
+// and addresses and bytes as 0x prefixed hex
+func abiSerializer() *abi.Serializer {
+	return abi.NewSerializer().
+		SetByteSerializer(abi.HexByteSerializer0xPrefix).
+		SetAddressSerializer(abi.HexAddrSerializer0xPrefix)
+}
+
+// parseABI parses an ABI from the given attribute, which can be the ABI array, or a single entry
+func parseABI(ctx context.Context, attribute, abiJSON string, diagnostics *diag.Diagnostics) abi.ABI {
+	a, err := parseABIJSON(ctx, abiJSON)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root(attribute), "invalid ABI", err.Error())
+		return nil
+	}
+	return a
+}
+
+// parseABIJSON parses and validates an ABI, which can be the ABI array, or a single entry
+func parseABIJSON(ctx context.Context, abiJSON string) (abi.ABI, error) {
+	var a abi.ABI
+	if err := json.Unmarshal([]byte(abiJSON), &a); err != nil {
+		var entry abi.Entry
+		if json.Unmarshal([]byte(abiJSON), &entry) != nil {
+			return nil, err
+		}
+		a = abi.ABI{&entry}
+	}
+	if err := a.ValidateCtx(ctx); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// abiFunction finds a function in the ABI by name, or by signature such as `balanceOf(address)`
+// where the function is overloaded
+func abiFunction(ctx context.Context, a abi.ABI, method string) (*abi.Entry, error) {
+	var found *abi.Entry
+	for _, e := range a {
+		if !e.IsFunction() {
+			continue
+		}
+		signature, err := e.SignatureCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if signature == method {
+			return e, nil
+		}
+		if e.Name == method {
+			if found != nil {
+				return nil, fmt.Errorf("function '%s' is overloaded, so must be specified by signature such as '%s'", method, signature)
+			}
+			found = e
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("function '%s' not found in ABI", method)
+	}
+	return found, nil
+}
+
+// revertReason decodes the reason from the revert data of a failed call, where the node returns it
+func revertReason(ctx context.Context, a abi.ABI, rpcErr *RPCError) string {
+	data, ok := rpcErr.Data.(string)
+	if !ok {
+		return ""
+	}
+	revertData, err := ethtypes.NewHexBytes0xPrefix(data)
+	if err != nil {
+		return ""
+	}
+	reason, _ := a.ErrorStringCtx(ctx, revertData)
+	return reason
+}
+
+func EVMCallDatasourceModelFactory() datasource.DataSource {
+	return &evm_callDatasource{}
+}
+
+type evm_callDatasource struct {
+	commonDataSource
+}
+
+func (r *evm_callDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_evm_call"
+}
+
+func (r *evm_callDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := evmEndpointAttributes()
+	attributes["address"] = &schema.StringAttribute{
+		Required:    true,
+		Description: "Address of the contract to call",
+	}
+	attributes["abi"] = &schema.StringAttribute{
+		Required:    true,
+		Description: "ABI of the contract as JSON, such as the `abi` of a `kaleido_platform_cms_build`. Can also be the ABI entry of the function on its own",
+	}
+	attributes["method"] = &schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the function to call, or its signature such as `balanceOf(address)` if the function is overloaded",
+	}
+	attributes["params_json"] = &schema.StringAttribute{
+		Optional:    true,
+		CustomType:  jsonStringType{},
+		Description: "Input parameters of the function as a JSON array, or as a JSON object keyed by parameter name",
+	}
+	attributes["from"] = &schema.StringAttribute{
+		Optional:    true,
+		Description: "Address to make the call from, for functions that depend on `msg.sender`",
+	}
+	attributes["block"] = &schema.StringAttribute{
+		Optional:    true,
+		Description: "Block number or tag to make the call against. Defaults to `latest`",
+	}
+	attributes["output"] = &schema.StringAttribute{
+		Computed:    true,
+		Description: "The first output of the function, such as the address returned by a registry lookup. Integers are base 10 strings, and structured values are JSON",
+	}
+	attributes["outputs_json"] = &schema.StringAttribute{
+		Computed:    true,
+		CustomType:  jsonStringType{},
+		Description: "All outputs of the function as a JSON object keyed by output name, or by index for unnamed outputs",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Call a read-only contract function with `eth_call`, and decode its outputs using the contract ABI.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *evm_callDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EVMCallDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a := parseABI(ctx, "abi", data.ABI.ValueString(), &resp.Diagnostics)
+	if a == nil {
+		return
+	}
+	function, err := abiFunction(ctx, a, data.Method.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("method"), "invalid method", err.Error())
+		return
+	}
+	params := "[]"
+	if data.ParamsJSON.ValueString() != "" {
+		params = data.ParamsJSON.ValueString()
+	}
+	callData, err := function.EncodeCallDataJSONCtx(ctx, []byte(params))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("params_json"), "invalid parameters", err.Error())
+		return
+	}
+	block := "latest"
+	if data.Block.ValueString() != "" {
+		block = data.Block.ValueString()
+	}
+
+	ep := r.evmRPCEndpoint(data.Environment, data.Service, data.JsonRpcURL, data.Username, data.Password, &resp.Diagnostics)
+	if ep == nil {
+		return
+	}
+	tx := EVMCallTransactionAPIModel{
+		From: data.From.ValueString(),
+		To:   data.Address.ValueString(),
+		Data: callData,
+	}
+	var result ethtypes.HexBytes0xPrefix
+	if err := r.rpcCall(ctx, ep, "eth_call", []interface{}{tx, block}, &result); err != nil {
+		if rpcErr, ok := err.(*RPCError); ok {
+			if reason := revertReason(ctx, a, rpcErr); reason != "" {
+				resp.Diagnostics.AddError(fmt.Sprintf("call to %s reverted", data.Method.ValueString()), reason)
+				return
+			}
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("call to %s failed", data.Method.ValueString()), err.Error())
+		return
+	}
+
+	outputs, err := function.Outputs.DecodeABIDataCtx(ctx, result, 0)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to decode outputs of %s", data.Method.ValueString()), err.Error())
+		return
+	}
+	outputsJSON, err := abiSerializer().SerializeJSONCtx(ctx, outputs)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to decode outputs of %s", data.Method.ValueString()), err.Error())
+		return
+	}
+	data.OutputsJSON = newJSONString(string(outputsJSON))
+	data.Output = types.StringValue("")
+	flatOutputs, err := abiSerializer().SetFormattingMode(abi.FormatAsFlatArrays).SerializeInterfaceCtx(ctx, outputs)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to decode outputs of %s", data.Method.ValueString()), err.Error())
+		return
+	}
+	if values, ok := flatOutputs.([]interface{}); ok && len(values) > 0 {
+		if s, ok := values[0].(string); ok {
+			data.Output = types.StringValue(s)
+		} else {
+			b, _ := json.Marshal(values[0])
+			data.Output = types.StringValue(string(b))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
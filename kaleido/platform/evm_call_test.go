@@ -0,0 +1,202 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hyperledger/firefly-signer/pkg/abi"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+const registryABI = `[
+	{
+		"type": "function",
+		"name": "lookup",
+		"stateMutability": "view",
+		"inputs": [{"name": "name", "type": "string"}],
+		"outputs": [{"name": "addr", "type": "address"}]
+	},
+	{
+		"type": "function",
+		"name": "lookup",
+		"stateMutability": "view",
+		"inputs": [{"name": "name", "type": "string"}, {"name": "version", "type": "uint256"}],
+		"outputs": [{"name": "addr", "type": "address"}]
+	}
+]`
+
+var evm_callStep1 = func(mp *mockPlatform) string {
+	return fmt.Sprintf(`
+data "kaleido_platform_evm_call" "evm_call1" {
+    json_rpc_url = "%s/json_rpc"
+    address = "0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a"
+    abi = <<EOT
+%s
+EOT
+    method = "lookup(string)"
+    params_json = jsonencode(["factory"])
+}
+`, mp.server.URL, registryABI)
+}
+
+var evm_callStep2 = func(mp *mockPlatform) string {
+	return fmt.Sprintf(`
+data "kaleido_platform_evm_call" "evm_call1" {
+    json_rpc_url = "%s/json_rpc"
+    address = "0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a"
+    abi = <<EOT
+%s
+EOT
+    method = "lookup"
+    params_json = jsonencode(["factory"])
+}
+`, mp.server.URL, registryABI)
+}
+
+func TestEVMCall(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /json_rpc",
+			"POST /json_rpc",
+			"POST /json_rpc",
+		})
+		mp.server.Close()
+	}()
+
+	a, err := abi.ParseABI([]byte(registryABI))
+	assert.NoError(t, err)
+	expectedData, err := a[0].EncodeCallDataValues([]interface{}{"factory"})
+	assert.NoError(t, err)
+	result, err := a[0].Outputs.EncodeABIDataValues([]interface{}{"0x9f4b0a3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a"})
+	assert.NoError(t, err)
+	mp.mockRPCHandler(func(jReq *RPCRequest) (interface{}, *RPCError) {
+		assert.Equal(t, "eth_call", jReq.Method)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"to":   "0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a",
+				"data": ethtypes.HexBytes0xPrefix(expectedData).String(),
+			},
+			"latest",
+		}, jReq.Params)
+		return ethtypes.HexBytes0xPrefix(result), nil
+	})
+
+	evm_call1 := "data.kaleido_platform_evm_call.evm_call1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + evm_callStep1(mp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(evm_call1, "output", "0x9f4b0a3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a"),
+					resource.TestCheckResourceAttr(evm_call1, "outputs_json", `{"addr":"0x9f4b0a3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestEVMCallOverloaded(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + evm_callStep2(mp),
+				ExpectError: regexp.MustCompile(`function 'lookup' is overloaded`),
+			},
+		},
+	})
+}
+
+func TestEVMCallReverted(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /json_rpc",
+		})
+		mp.server.Close()
+	}()
+
+	errorEntry := &abi.Entry{Type: abi.Error, Name: "Error", Inputs: abi.ParameterArray{{Type: "string"}}}
+	revertData, err := errorEntry.EncodeCallDataValues([]interface{}{"name not registered"})
+	assert.NoError(t, err)
+	mp.mockRPCHandler(func(jReq *RPCRequest) (interface{}, *RPCError) {
+		return nil, &RPCError{Code: 3, Message: "execution reverted", Data: ethtypes.HexBytes0xPrefix(revertData).String()}
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + evm_callStep1(mp),
+				ExpectError: regexp.MustCompile(`name not registered`),
+			},
+		},
+	})
+}
+
+func TestABIFunction(t *testing.T) {
+	a, err := abi.ParseABI([]byte(registryABI))
+	assert.NoError(t, err)
+
+	e, err := abiFunction(context.Background(), a, "lookup(string,uint256)")
+	assert.NoError(t, err)
+	assert.Len(t, e.Inputs, 2)
+
+	_, err = abiFunction(context.Background(), a, "lookup")
+	assert.Regexp(t, "overloaded", err)
+
+	_, err = abiFunction(context.Background(), a, "register")
+	assert.Regexp(t, "not found", err)
+}
+
+func (mp *mockPlatform) mockRPCHandler(handler func(jReq *RPCRequest) (interface{}, *RPCError)) {
+	mp.register("/json_rpc", http.MethodPost, func(res http.ResponseWriter, req *http.Request) {
+		var jReq RPCRequest
+		err := json.NewDecoder(req.Body).Decode(&jReq)
+		assert.NoError(mp.t, err)
+
+		result, rpcErr := handler(&jReq)
+		jRes := RPCResponse{
+			JSONRpc: "2.0",
+			ID:      jReq.ID,
+			Error:   rpcErr,
+		}
+		if rpcErr == nil {
+			jRes.Result, err = json.Marshal(result)
+			assert.NoError(mp.t, err)
+		}
+		res.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(res).Encode(jRes)
+		assert.NoError(mp.t, err)
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+)
+
+func EVMChecksumAddressFunctionFactory() function.Function {
+	return &evm_checksum_addressFunction{}
+}
+
+type evm_checksum_addressFunction struct{}
+
+func (f *evm_checksum_addressFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evm_checksum_address"
+}
+
+func (f *evm_checksum_addressFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Format an EVM address with an EIP-55 checksum",
+		Description: "Returns the given EVM address in the mixed-case checksum format defined by EIP-55, such as `0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed`. The address can be given in any case, with or without a `0x` prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "EVM address, as 20 bytes of hex",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *evm_checksum_addressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+	resp.Error = req.Arguments.Get(ctx, &address)
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := ethtypes.NewAddressWithChecksum(address)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, a.String())
+}
@@ -0,0 +1,39 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEVMChecksumAddressFunction(t *testing.T) {
+	for _, address := range []string{
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+		"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+	} {
+		result, funcErr := testRunFunction(t, EVMChecksumAddressFunctionFactory(), address)
+		assert.Nil(t, funcErr)
+		assert.Equal(t, types.StringValue("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), result)
+	}
+}
+
+func TestEVMChecksumAddressFunctionInvalid(t *testing.T) {
+	_, funcErr := testRunFunction(t, EVMChecksumAddressFunctionFactory(), "0x1234")
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "bad address", funcErr.Text)
+}
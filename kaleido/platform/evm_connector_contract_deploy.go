@@ -29,31 +29,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
 
 const evmConnectorDeployDefaultWaitTimeout = 10 * time.Minute
 
 type EVMConnectorContractDeployResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Environment     types.String `tfsdk:"environment"`
-	Service         types.String `tfsdk:"service"`
-	API             types.String `tfsdk:"api"`
-	Key             types.String `tfsdk:"key"`
-	ABI             types.String `tfsdk:"abi"`
-	Bytecode        types.String `tfsdk:"bytecode"`
-	ParamsJSON      types.String `tfsdk:"params_json"`
-	Value           types.String `tfsdk:"value"`
-	Gas             types.String `tfsdk:"gas"`
-	Nonce           types.String `tfsdk:"nonce"`
-	OptionsJSON     types.String `tfsdk:"options_json"`
-	IdempotencyKey  types.String `tfsdk:"idempotency_key"`
-	WaitTimeout     types.String `tfsdk:"wait_timeout"`
-	IgnoreDestroy   types.Bool   `tfsdk:"ignore_destroy"`
-	ContractAddress types.String `tfsdk:"contract_address"`
-	TransactionHash types.String `tfsdk:"transaction_hash"`
-	BlockNumber     types.String `tfsdk:"block_number"`
+	ID              types.String  `tfsdk:"id"`
+	Environment     types.String  `tfsdk:"environment"`
+	Service         types.String  `tfsdk:"service"`
+	API             types.String  `tfsdk:"api"`
+	Key             types.String  `tfsdk:"key"`
+	ABI             types.String  `tfsdk:"abi"`
+	Bytecode        types.String  `tfsdk:"bytecode"`
+	ParamsJSON      jsonStringVal `tfsdk:"params_json"`
+	Value           types.String  `tfsdk:"value"`
+	Gas             types.String  `tfsdk:"gas"`
+	Nonce           types.String  `tfsdk:"nonce"`
+	OptionsJSON     jsonStringVal `tfsdk:"options_json"`
+	IdempotencyKey  types.String  `tfsdk:"idempotency_key"`
+	WaitTimeout     types.String  `tfsdk:"wait_timeout"`
+	IgnoreDestroy   types.Bool    `tfsdk:"ignore_destroy"`
+	ContractAddress types.String  `tfsdk:"contract_address"`
+	TransactionHash types.String  `tfsdk:"transaction_hash"`
+	BlockNumber     types.String  `tfsdk:"block_number"`
 }
 
 // EVMConnectorDeployInputAPIModel is the standard EVM API "contract/deploy" operation input
@@ -155,6 +153,7 @@ func (r *evmConnectorContractDeployResource) Schema(_ context.Context, _ resourc
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"params_json": &schema.StringAttribute{
+				CustomType:    jsonStringType{},
 				Optional:      true,
 				Description:   "Constructor parameters as a JSON array or object string",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
@@ -175,6 +174,7 @@ func (r *evmConnectorContractDeployResource) Schema(_ context.Context, _ resourc
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"options_json": &schema.StringAttribute{
+				CustomType:    jsonStringType{},
 				Optional:      true,
 				Description:   "Additional options for the deployment, as a JSON object string (EVM connector semantics)",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
@@ -339,7 +339,7 @@ func (r *evmConnectorContractDeployResource) waitForTransaction(ctx context.Cont
 	waitPath := r.transactionPath(data, txID, "/wait")
 	cancelInfo := APICancelInfo()
 	cancelInfo.CancelInfo = "(waiting for deploy transaction submission)"
-	err := kaleidobase.Retry.Do(waitCtx, fmt.Sprintf("deploy-wait %s", waitPath), func(attempt int) (retry bool, err error) {
+	err := r.retry().Do(waitCtx, fmt.Sprintf("deploy-wait %s", waitPath), func(attempt int) (retry bool, err error) {
 		attemptDiags := diag.Diagnostics{}
 		ok, statusCode := r.apiRequest(waitCtx, http.MethodGet, waitPath, nil, api, &attemptDiags, cancelInfo)
 		if !ok {
@@ -392,7 +392,7 @@ func (r *evmConnectorContractDeployResource) Create(ctx context.Context, req res
 	txID := ""
 	var result EVMConnectorSubmitResultAPIModel
 	submitDiags := diag.Diagnostics{}
-	ok, statusCode := r.apiRequest(ctx, http.MethodPost, r.deployPath(&data), submit, &result, &submitDiags)
+	ok, statusCode := r.apiRequest(ctx, http.MethodPost, r.deployPath(&data), submit, &result, &submitDiags, IdempotencyKey(submit.IdempotencyKey))
 	switch {
 	case ok:
 		txID = result.ID
@@ -480,3 +480,7 @@ func (r *evmConnectorContractDeployResource) Delete(ctx context.Context, req res
 	// transaction record frees the idempotency key so a recreate submits a fresh deployment.
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.transactionPath(&data, data.ID.ValueString(), ""), nil, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *evmConnectorContractDeployResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "api", "id")
+}
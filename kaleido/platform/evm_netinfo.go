@@ -21,10 +21,13 @@ import (
 	"math/big"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
 )
 
 type EVMNetInfoDatasourceModel struct {
@@ -34,6 +37,21 @@ type EVMNetInfoDatasourceModel struct {
 	Username    types.String `tfsdk:"username"`
 	Password    types.String `tfsdk:"password"`
 	ChainID     types.Int64  `tfsdk:"chain_id"`
+
+	IncludeMetadata  types.Bool   `tfsdk:"include_metadata"`
+	Consensus        types.String `tfsdk:"consensus"`
+	BlockNumber      types.Int64  `tfsdk:"block_number"`
+	GenesisBlockHash types.String `tfsdk:"genesis_block_hash"`
+	ClientVersion    types.String `tfsdk:"client_version"`
+	PeerCount        types.Int64  `tfsdk:"peer_count"`
+	Syncing          types.Bool   `tfsdk:"syncing"`
+	GasPrice         types.String `tfsdk:"gas_price"`
+	Validators       types.List   `tfsdk:"validators"`
+}
+
+type EVMBlockAPIModel struct {
+	Number ethtypes.HexUint64        `json:"number"`
+	Hash   ethtypes.HexBytes0xPrefix `json:"hash"`
 }
 
 type RPCRequest struct {
@@ -56,106 +74,260 @@ type RPCError struct {
 	Data    interface{} `json:"data,omitempty"`
 }
 
-func EVMNetInfoDataSourceFactory() datasource.DataSource {
-	return &evm_netinfoDatasource{}
-}
-
-type evm_netinfoDatasource struct {
-	commonDataSource
-}
-
-func (r *evm_netinfoDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = "kaleido_platform_evm_netinfo"
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("[%d] %s", e.Code, e.Message)
 }
 
-func (r *evm_netinfoDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"environment": &schema.StringAttribute{
-				Optional: true,
-			},
-			"service": &schema.StringAttribute{
-				Optional: true,
-			},
-			"json_rpc_url": &schema.StringAttribute{
-				Optional: true,
-			},
-			"username": &schema.StringAttribute{
-				Optional: true,
-			},
-			"password": &schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"chain_id": &schema.Int64Attribute{
-				Computed: true,
-			},
+// evmEndpointAttributes are the attributes that select the JSON/RPC endpoint of a node or
+// connector, shared by the data sources that query an EVM chain
+func evmEndpointAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"environment": &schema.StringAttribute{
+			Optional:    true,
+			Description: "Environment ID. Set with `service` to call a node or connector through the platform",
+		},
+		"service": &schema.StringAttribute{
+			Optional:    true,
+			Description: "Service ID of the node or connector",
+		},
+		"json_rpc_url": &schema.StringAttribute{
+			Optional:    true,
+			Description: "URL of a JSON/RPC endpoint to call directly, instead of `environment` and `service`",
+		},
+		"username": &schema.StringAttribute{
+			Optional:    true,
+			Description: "Basic auth username for `json_rpc_url`",
+		},
+		"password": &schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Basic auth password for `json_rpc_url`",
 		},
 	}
 }
 
-func (r *evm_netinfoDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-
-	var data EVMNetInfoDatasourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+type evmRPCEndpoint struct {
+	client *resty.Client
+	url    string
+}
 
-	reqID := 0
-	url := data.JsonRpcURL.ValueString()
-	client := resty.New()
-	if data.Username.ValueString() != "" && data.Password.ValueString() != "" {
-		client = client.SetBasicAuth(data.Username.ValueString(), data.Password.ValueString())
+func (r *commonDataSource) evmRPCEndpoint(environment, service, jsonRPCURL, username, password types.String, diagnostics *diag.Diagnostics) *evmRPCEndpoint {
+	ep := &evmRPCEndpoint{
+		client: resty.New(),
+		url:    jsonRPCURL.ValueString(),
 	}
-	if data.Environment.ValueString() != "" && data.Service.ValueString() != "" {
-		client = r.ProviderData.Platform
-		url = fmt.Sprintf("/endpoint/%s/%s/jsonrpc", data.Environment.ValueString(), data.Service.ValueString())
+	if username.ValueString() != "" && password.ValueString() != "" {
+		ep.client = ep.client.SetBasicAuth(username.ValueString(), password.ValueString())
 	}
-	if url == "" {
-		resp.Diagnostics.AddError("no endpoint specified", "either configure environment and service, or set json_rpc_url directly")
-		return
+	if environment.ValueString() != "" && service.ValueString() != "" {
+		ep.client = r.ProviderData.Platform
+		ep.url = fmt.Sprintf("/endpoint/%s/%s/jsonrpc", environment.ValueString(), service.ValueString())
+	}
+	if ep.url == "" {
+		diagnostics.AddError("no endpoint specified", "either configure environment and service, or set json_rpc_url directly")
+		return nil
 	}
-	var jRes RPCResponse
-	_ = kaleidobase.Retry.Do(ctx, "eth_chainId", func(attempt int) (retry bool, err error) {
+	return ep
+}
+
+// rpcCall performs a JSON/RPC call, retrying until the endpoint returns a response. Errors returned
+// by the node in the response, such as a reverted call, are not retried and are returned as an *RPCError
+func (r *commonDataSource) rpcCall(ctx context.Context, ep *evmRPCEndpoint, method string, params []interface{}, result interface{}) error {
+	reqID := 0
+	var rpcErr *RPCError
+	err := r.retry().Do(ctx, method, func(attempt int) (retry bool, err error) {
 		reqID++
-		req := client.R().
+		req := ep.client.R().
 			SetBody(RPCRequest{
 				JSONRpc: "2.0",
 				ID:      reqID,
-				Method:  "eth_chainId",
-				Params:  []interface{}{},
+				Method:  method,
+				Params:  params,
 			}).
 			SetHeader("Content-Type", "application/json").
 			SetContext(ctx).
 			SetDoNotParseResponse(true)
-		res, err := req.Post(url)
+		res, err := req.Post(ep.url)
 		rawResponse := []byte{}
 		if err == nil {
 			rawResponse, err = io.ReadAll(res.RawBody())
 			res.RawBody().Close()
 		}
 		if err == nil && res.IsSuccess() {
+			var jRes RPCResponse
 			parseErr := json.Unmarshal(rawResponse, &jRes)
+			if parseErr == nil && jRes.Error != nil {
+				rpcErr = jRes.Error
+				return false, rpcErr
+			}
 			if parseErr == nil {
-				var stringChainID string
-				parseErr = json.Unmarshal(jRes.Result, &stringChainID)
+				parseErr = json.Unmarshal(jRes.Result, result)
 				if parseErr == nil {
-					chainID, ok := new(big.Int).SetString(stringChainID, 0)
-					if !ok {
-						// This upgrades to be the error
-						err = fmt.Errorf("invalid chainId in response: %s", rawResponse)
-					} else {
-						// Success!
-						data.ChainID = types.Int64Value(chainID.Int64())
-						return false, nil
-					}
+					// Success!
+					return false, nil
 				}
 			}
 		}
-		// Retry on all paths where we've not parsed out a chain ID successfully
+		// Retry on all paths where we've not parsed out a result successfully
 		if err != nil {
-			return true, fmt.Errorf("JSON/RPC call to %s failed: %s", url, err)
+			return true, fmt.Errorf("JSON/RPC call to %s failed: %s", ep.url, err)
 		}
-		return true, fmt.Errorf("JSON/RPC call to %s returned [%d]: %s", url, res.StatusCode(), rawResponse)
+		return true, fmt.Errorf("JSON/RPC call to %s returned [%d]: %s", ep.url, res.StatusCode(), rawResponse)
 	})
+	if rpcErr != nil {
+		return rpcErr
+	}
+	return err
+}
+
+func EVMNetInfoDataSourceFactory() datasource.DataSource {
+	return &evm_netinfoDatasource{}
+}
+
+type evm_netinfoDatasource struct {
+	commonDataSource
+}
+
+func (r *evm_netinfoDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_evm_netinfo"
+}
+
+func (r *evm_netinfoDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := evmEndpointAttributes()
+	attributes["chain_id"] = &schema.Int64Attribute{
+		Computed: true,
+	}
+	attributes["include_metadata"] = &schema.BoolAttribute{
+		Optional:    true,
+		Description: "Also query the block number, genesis block hash, client version, peer count, syncing status and gas price of the chain",
+	}
+	attributes["consensus"] = &schema.StringAttribute{
+		Optional:    true,
+		Description: "Consensus algorithm of the chain, to query its validators at the latest block. Options: `qbft`, `ibft`",
+		Validators:  []validator.String{stringvalidator.OneOf("qbft", "ibft")},
+	}
+	attributes["block_number"] = &schema.Int64Attribute{
+		Computed:    true,
+		Description: "Latest block number, when `include_metadata` is set",
+	}
+	attributes["genesis_block_hash"] = &schema.StringAttribute{
+		Computed:    true,
+		Description: "Hash of block 0, when `include_metadata` is set",
+	}
+	attributes["client_version"] = &schema.StringAttribute{
+		Computed:    true,
+		Description: "Client version reported by the node, when `include_metadata` is set",
+	}
+	attributes["peer_count"] = &schema.Int64Attribute{
+		Computed:    true,
+		Description: "Number of peers connected to the node, when `include_metadata` is set",
+	}
+	attributes["syncing"] = &schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the node is still syncing with the chain, when `include_metadata` is set",
+	}
+	attributes["gas_price"] = &schema.StringAttribute{
+		Computed:    true,
+		Description: "Gas price in wei as a base 10 string, when `include_metadata` is set",
+	}
+	attributes["validators"] = &schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Addresses of the validators at the latest block, when `consensus` is set",
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *evm_netinfoDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var data EVMNetInfoDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	ep := r.evmRPCEndpoint(data.Environment, data.Service, data.JsonRpcURL, data.Username, data.Password, &resp.Diagnostics)
+	if ep == nil {
+		return
+	}
+	var stringChainID string
+	if err := r.rpcCall(ctx, ep, "eth_chainId", []interface{}{}, &stringChainID); err != nil {
+		resp.Diagnostics.AddError("failed to query chain ID", err.Error())
+		return
+	}
+	chainID, ok := new(big.Int).SetString(stringChainID, 0)
+	if !ok {
+		resp.Diagnostics.AddError("failed to query chain ID", fmt.Sprintf("invalid chainId in response: %s", stringChainID))
+		return
+	}
+	data.ChainID = types.Int64Value(chainID.Int64())
+
+	if data.IncludeMetadata.ValueBool() {
+		r.readChainMetadata(ctx, ep, &data, &resp.Diagnostics)
+	}
+	data.Validators = types.ListNull(types.StringType)
+	if data.Consensus.ValueString() != "" {
+		method := data.Consensus.ValueString() + "_getValidatorsByBlockNumber"
+		var validators []string
+		if err := r.rpcCall(ctx, ep, method, []interface{}{"latest"}, &validators); err != nil {
+			resp.Diagnostics.AddError("failed to query validators", err.Error())
+			return
+		}
+		var d diag.Diagnostics
+		data.Validators, d = types.ListValueFrom(ctx, types.StringType, validators)
+		resp.Diagnostics.Append(d...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 }
+
+func (r *evm_netinfoDatasource) readChainMetadata(ctx context.Context, ep *evmRPCEndpoint, data *EVMNetInfoDatasourceModel, diagnostics *diag.Diagnostics) {
+	var blockNumber ethtypes.HexUint64
+	if err := r.rpcCall(ctx, ep, "eth_blockNumber", []interface{}{}, &blockNumber); err != nil {
+		diagnostics.AddError("failed to query block number", err.Error())
+		return
+	}
+	data.BlockNumber = types.Int64Value(int64(blockNumber.Uint64()))
+
+	var genesis *EVMBlockAPIModel
+	if err := r.rpcCall(ctx, ep, "eth_getBlockByNumber", []interface{}{"0x0", false}, &genesis); err != nil {
+		diagnostics.AddError("failed to query genesis block", err.Error())
+		return
+	}
+	if genesis == nil {
+		diagnostics.AddError("failed to query genesis block", "block 0 was not returned by the node")
+		return
+	}
+	data.GenesisBlockHash = types.StringValue(genesis.Hash.String())
+
+	var clientVersion string
+	if err := r.rpcCall(ctx, ep, "web3_clientVersion", []interface{}{}, &clientVersion); err != nil {
+		diagnostics.AddError("failed to query client version", err.Error())
+		return
+	}
+	data.ClientVersion = types.StringValue(clientVersion)
+
+	var peerCount ethtypes.HexUint64
+	if err := r.rpcCall(ctx, ep, "net_peerCount", []interface{}{}, &peerCount); err != nil {
+		diagnostics.AddError("failed to query peer count", err.Error())
+		return
+	}
+	data.PeerCount = types.Int64Value(int64(peerCount.Uint64()))
+
+	// eth_syncing returns false once synced, or an object describing the sync progress
+	var syncing json.RawMessage
+	if err := r.rpcCall(ctx, ep, "eth_syncing", []interface{}{}, &syncing); err != nil {
+		diagnostics.AddError("failed to query syncing status", err.Error())
+		return
+	}
+	data.Syncing = types.BoolValue(string(syncing) != "false")
+
+	var gasPrice ethtypes.HexInteger
+	if err := r.rpcCall(ctx, ep, "eth_gasPrice", []interface{}{}, &gasPrice); err != nil {
+		diagnostics.AddError("failed to query gas price", err.Error())
+		return
+	}
+	data.GasPrice = types.StringValue(gasPrice.BigInt().String())
+}
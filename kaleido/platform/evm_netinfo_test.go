@@ -64,6 +64,81 @@ func TestEVMNetInfo1(t *testing.T) {
 	})
 }
 
+var evm_netinfoStep2 = func(mp *mockPlatform) string {
+	return fmt.Sprintf(`
+data "kaleido_platform_evm_netinfo" "evm_netinfo1" {
+    json_rpc_url = "%s/json_rpc"
+    include_metadata = true
+    consensus = "qbft"
+}
+`, mp.server.URL)
+}
+
+func TestEVMNetInfoMetadata(t *testing.T) {
+
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		calls := []string{}
+		for i := 0; i < 3*8; i++ {
+			calls = append(calls, "POST /json_rpc")
+		}
+		mp.checkClearCalls(calls)
+		mp.server.Close()
+	}()
+
+	mp.mockRPCHandler(func(jReq *RPCRequest) (interface{}, *RPCError) {
+		switch jReq.Method {
+		case "eth_chainId":
+			return "0xAB4130", nil
+		case "eth_blockNumber":
+			return "0x3039", nil
+		case "eth_getBlockByNumber":
+			assert.Equal(t, []interface{}{"0x0", false}, jReq.Params)
+			return map[string]interface{}{
+				"number": "0x0",
+				"hash":   "0x1d2c3b4a5f6e7d8c9b0a1f2e3d4c5b6a7f8e9d0c1b2a3f4e5d6c7b8a9f0e1d2c",
+			}, nil
+		case "web3_clientVersion":
+			return "besu/v24.12.0/linux-x86_64/openjdk-java-21", nil
+		case "net_peerCount":
+			return "0x3", nil
+		case "eth_syncing":
+			return false, nil
+		case "eth_gasPrice":
+			return "0x3b9aca00", nil
+		case "qbft_getValidatorsByBlockNumber":
+			assert.Equal(t, []interface{}{"latest"}, jReq.Params)
+			return []string{
+				"0x1111111111111111111111111111111111111111",
+				"0x2222222222222222222222222222222222222222",
+			}, nil
+		}
+		return nil, &RPCError{Code: -32601, Message: "Method not found"}
+	})
+
+	evm_netinfo1Resource := "data.kaleido_platform_evm_netinfo.evm_netinfo1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + evm_netinfoStep2(mp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "chain_id", `11223344`),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "block_number", `12345`),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "genesis_block_hash", "0x1d2c3b4a5f6e7d8c9b0a1f2e3d4c5b6a7f8e9d0c1b2a3f4e5d6c7b8a9f0e1d2c"),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "client_version", "besu/v24.12.0/linux-x86_64/openjdk-java-21"),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "peer_count", `3`),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "syncing", `false`),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "gas_price", `1000000000`),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "validators.#", `2`),
+					resource.TestCheckResourceAttr(evm_netinfo1Resource, "validators.1", "0x2222222222222222222222222222222222222222"),
+				),
+			},
+		},
+	})
+}
+
 func (mp *mockPlatform) mockRPC(method string, params []interface{}, result interface{}, failures ...int) {
 	callCount := 0
 	mp.register("/json_rpc", http.MethodPost, func(res http.ResponseWriter, req *http.Request) {
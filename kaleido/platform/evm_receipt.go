@@ -0,0 +1,244 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hyperledger/firefly-signer/pkg/abi"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+)
+
+type EVMReceiptDatasourceModel struct {
+	Environment     types.String                   `tfsdk:"environment"`
+	Service         types.String                   `tfsdk:"service"`
+	JsonRpcURL      types.String                   `tfsdk:"json_rpc_url"`
+	Username        types.String                   `tfsdk:"username"`
+	Password        types.String                   `tfsdk:"password"`
+	TransactionHash types.String                   `tfsdk:"transaction_hash"`
+	ABI             types.String                   `tfsdk:"abi"`
+	BlockNumber     types.Int64                    `tfsdk:"block_number"`
+	BlockHash       types.String                   `tfsdk:"block_hash"`
+	Success         types.Bool                     `tfsdk:"success"`
+	From            types.String                   `tfsdk:"from"`
+	To              types.String                   `tfsdk:"to"`
+	ContractAddress types.String                   `tfsdk:"contract_address"`
+	GasUsed         types.Int64                    `tfsdk:"gas_used"`
+	Logs            []EVMReceiptLogDatasourceModel `tfsdk:"logs"`
+}
+
+type EVMReceiptLogDatasourceModel struct {
+	LogIndex  types.Int64   `tfsdk:"log_index"`
+	Address   types.String  `tfsdk:"address"`
+	Topics    types.List    `tfsdk:"topics"`
+	Data      types.String  `tfsdk:"data"`
+	Event     types.String  `tfsdk:"event"`
+	EventJSON jsonStringVal `tfsdk:"event_json"`
+}
+
+type EVMReceiptAPIModel struct {
+	TransactionHash ethtypes.HexBytes0xPrefix `json:"transactionHash"`
+	BlockNumber     ethtypes.HexUint64        `json:"blockNumber"`
+	BlockHash       ethtypes.HexBytes0xPrefix `json:"blockHash"`
+	Status          ethtypes.HexUint64        `json:"status"`
+	From            *ethtypes.Address0xHex    `json:"from,omitempty"`
+	To              *ethtypes.Address0xHex    `json:"to,omitempty"`
+	ContractAddress *ethtypes.Address0xHex    `json:"contractAddress,omitempty"`
+	GasUsed         ethtypes.HexUint64        `json:"gasUsed"`
+	Logs            []*EVMLogAPIModel         `json:"logs"`
+}
+
+type EVMLogAPIModel struct {
+	LogIndex ethtypes.HexUint64          `json:"logIndex"`
+	Address  *ethtypes.Address0xHex      `json:"address"`
+	Topics   []ethtypes.HexBytes0xPrefix `json:"topics"`
+	Data     ethtypes.HexBytes0xPrefix   `json:"data"`
+}
+
+func addressToData(addr *ethtypes.Address0xHex) types.String {
+	if addr == nil {
+		return types.StringValue("")
+	}
+	return types.StringValue(addr.String())
+}
+
+func (api *EVMReceiptAPIModel) toData(ctx context.Context, data *EVMReceiptDatasourceModel, a abi.ABI, diagnostics *diag.Diagnostics) {
+	data.BlockNumber = types.Int64Value(int64(api.BlockNumber.Uint64()))
+	data.BlockHash = types.StringValue(api.BlockHash.String())
+	data.Success = types.BoolValue(api.Status.Uint64() == 1)
+	data.From = addressToData(api.From)
+	data.To = addressToData(api.To)
+	data.ContractAddress = addressToData(api.ContractAddress)
+	data.GasUsed = types.Int64Value(int64(api.GasUsed.Uint64()))
+	data.Logs = make([]EVMReceiptLogDatasourceModel, len(api.Logs))
+	for i, log := range api.Logs {
+		data.Logs[i] = log.toData(ctx, a, diagnostics)
+	}
+}
+
+func (api *EVMLogAPIModel) toData(ctx context.Context, a abi.ABI, diagnostics *diag.Diagnostics) EVMReceiptLogDatasourceModel {
+	topics := make([]string, len(api.Topics))
+	for i, topic := range api.Topics {
+		topics[i] = topic.String()
+	}
+	topicsList, d := types.ListValueFrom(ctx, types.StringType, topics)
+	diagnostics.Append(d...)
+	log := EVMReceiptLogDatasourceModel{
+		LogIndex:  types.Int64Value(int64(api.LogIndex.Uint64())),
+		Address:   addressToData(api.Address),
+		Topics:    topicsList,
+		Data:      types.StringValue(api.Data.String()),
+		Event:     types.StringValue(""),
+		EventJSON: jsonStringNull(),
+	}
+	// Logs from contracts other than the one the ABI is for are left undecoded, as are anonymous events
+	if len(api.Topics) == 0 {
+		return log
+	}
+	for _, e := range a {
+		if e.Type != abi.Event || e.Anonymous || e.SignatureHashBytes().String() != api.Topics[0].String() {
+			continue
+		}
+		values, err := e.DecodeEventDataCtx(ctx, api.Topics, api.Data)
+		if err != nil {
+			continue
+		}
+		eventJSON, err := abiSerializer().SerializeJSONCtx(ctx, values)
+		if err != nil {
+			continue
+		}
+		signature, _ := e.SignatureCtx(ctx)
+		log.Event = types.StringValue(signature)
+		log.EventJSON = newJSONString(string(eventJSON))
+		break
+	}
+	return log
+}
+
+func EVMReceiptDatasourceModelFactory() datasource.DataSource {
+	return &evm_receiptDatasource{}
+}
+
+type evm_receiptDatasource struct {
+	commonDataSource
+}
+
+func (r *evm_receiptDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_evm_receipt"
+}
+
+func (r *evm_receiptDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := evmEndpointAttributes()
+	attributes["transaction_hash"] = &schema.StringAttribute{
+		Required:    true,
+		Description: "Hash of the transaction",
+	}
+	attributes["abi"] = &schema.StringAttribute{
+		Optional:    true,
+		Description: "ABI as JSON, used to decode the events in the logs of the receipt",
+	}
+	attributes["block_number"] = &schema.Int64Attribute{
+		Computed: true,
+	}
+	attributes["block_hash"] = &schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["success"] = &schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the transaction succeeded, rather than reverted",
+	}
+	attributes["from"] = &schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["to"] = &schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["contract_address"] = &schema.StringAttribute{
+		Computed:    true,
+		Description: "Address of the contract created by the transaction, for contract deployments",
+	}
+	attributes["gas_used"] = &schema.Int64Attribute{
+		Computed: true,
+	}
+	attributes["logs"] = &schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"log_index": &schema.Int64Attribute{
+					Computed: true,
+				},
+				"address": &schema.StringAttribute{
+					Computed: true,
+				},
+				"topics": &schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"data": &schema.StringAttribute{
+					Computed: true,
+				},
+				"event": &schema.StringAttribute{
+					Computed:    true,
+					Description: "Signature of the event, where it was decoded using the `abi`",
+				},
+				"event_json": &schema.StringAttribute{
+					Computed:    true,
+					CustomType:  jsonStringType{},
+					Description: "Parameters of the event as a JSON object, where it was decoded using the `abi`",
+				},
+			},
+		},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Fetch the receipt of a mined transaction, and decode the events in its logs using an ABI.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *evm_receiptDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EVMReceiptDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var a abi.ABI
+	if data.ABI.ValueString() != "" {
+		if a = parseABI(ctx, "abi", data.ABI.ValueString(), &resp.Diagnostics); a == nil {
+			return
+		}
+	}
+
+	ep := r.evmRPCEndpoint(data.Environment, data.Service, data.JsonRpcURL, data.Username, data.Password, &resp.Diagnostics)
+	if ep == nil {
+		return
+	}
+	var receipt *EVMReceiptAPIModel
+	if err := r.rpcCall(ctx, ep, "eth_getTransactionReceipt", []interface{}{data.TransactionHash.ValueString()}, &receipt); err != nil {
+		resp.Diagnostics.AddError("failed to get transaction receipt", err.Error())
+		return
+	}
+	if receipt == nil {
+		resp.Diagnostics.AddError("transaction receipt not found", fmt.Sprintf("No receipt was found for transaction %s. The transaction might not have been mined yet", data.TransactionHash.ValueString()))
+		return
+	}
+
+	receipt.toData(ctx, &data, a, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,145 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hyperledger/firefly-signer/pkg/abi"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+const registeredEventABI = `[
+	{
+		"type": "event",
+		"name": "Registered",
+		"inputs": [
+			{"name": "addr", "type": "address", "indexed": true},
+			{"name": "name", "type": "string", "indexed": false}
+		]
+	}
+]`
+
+var evm_receiptStep1 = func(mp *mockPlatform) string {
+	return fmt.Sprintf(`
+data "kaleido_platform_evm_receipt" "evm_receipt1" {
+    json_rpc_url = "%s/json_rpc"
+    transaction_hash = "0x6f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"
+    abi = <<EOT
+%s
+EOT
+}
+`, mp.server.URL, registeredEventABI)
+}
+
+func TestEVMReceipt(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /json_rpc",
+			"POST /json_rpc",
+			"POST /json_rpc",
+		})
+		mp.server.Close()
+	}()
+
+	a, err := abi.ParseABI([]byte(registeredEventABI))
+	assert.NoError(t, err)
+	addrTopic, err := abi.ParameterArray{{Type: "address"}}.EncodeABIDataValues([]interface{}{"0x9f4b0a3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a"})
+	assert.NoError(t, err)
+	logData, err := abi.ParameterArray{{Type: "string"}}.EncodeABIDataValues([]interface{}{"factory"})
+	assert.NoError(t, err)
+	mp.mockRPCHandler(func(jReq *RPCRequest) (interface{}, *RPCError) {
+		assert.Equal(t, "eth_getTransactionReceipt", jReq.Method)
+		assert.Equal(t, []interface{}{"0x6f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"}, jReq.Params)
+		return map[string]interface{}{
+			"transactionHash": "0x6f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8",
+			"blockNumber":     "0x2a",
+			"blockHash":       "0x1d2c3b4a5f6e7d8c9b0a1f2e3d4c5b6a7f8e9d0c1b2a3f4e5d6c7b8a9f0e1d2c",
+			"status":          "0x1",
+			"from":            "0x1111111111111111111111111111111111111111",
+			"to":              "0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a",
+			"contractAddress": nil,
+			"gasUsed":         "0x5208",
+			"logs": []interface{}{
+				map[string]interface{}{
+					"logIndex": "0x0",
+					"address":  "0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a",
+					"topics": []string{
+						a[0].SignatureHashBytes().String(),
+						ethtypes.HexBytes0xPrefix(addrTopic).String(),
+					},
+					"data": ethtypes.HexBytes0xPrefix(logData).String(),
+				},
+				map[string]interface{}{
+					"logIndex": "0x1",
+					"address":  "0x2222222222222222222222222222222222222222",
+					"topics":   []string{"0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"},
+					"data":     "0x",
+				},
+			},
+		}, nil
+	})
+
+	evm_receipt1 := "data.kaleido_platform_evm_receipt.evm_receipt1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + evm_receiptStep1(mp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(evm_receipt1, "block_number", "42"),
+					resource.TestCheckResourceAttr(evm_receipt1, "success", "true"),
+					resource.TestCheckResourceAttr(evm_receipt1, "gas_used", "21000"),
+					resource.TestCheckResourceAttr(evm_receipt1, "contract_address", ""),
+					resource.TestCheckResourceAttr(evm_receipt1, "logs.#", "2"),
+					resource.TestCheckResourceAttr(evm_receipt1, "logs.0.event", "Registered(address,string)"),
+					resource.TestCheckResourceAttr(evm_receipt1, "logs.0.event_json", `{"addr":"0x9f4b0a3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a","name":"factory"}`),
+					resource.TestCheckResourceAttr(evm_receipt1, "logs.1.event", ""),
+					resource.TestCheckNoResourceAttr(evm_receipt1, "logs.1.event_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestEVMReceiptNotFound(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /json_rpc",
+		})
+		mp.server.Close()
+	}()
+
+	mp.mockRPCHandler(func(jReq *RPCRequest) (interface{}, *RPCError) {
+		return nil, nil
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + evm_receiptStep1(mp),
+				ExpectError: regexp.MustCompile(`transaction receipt not found`),
+			},
+		},
+	})
+}
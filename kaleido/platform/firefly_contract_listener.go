@@ -29,12 +29,12 @@ import (
 )
 
 type FireFlyContractListenerResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Environment types.String `tfsdk:"environment"`
-	Service     types.String `tfsdk:"service"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Name        types.String `tfsdk:"name"`
-	ConfigJSON  types.String `tfsdk:"config_json"`
+	ID          types.String  `tfsdk:"id"`
+	Environment types.String  `tfsdk:"environment"`
+	Service     types.String  `tfsdk:"service"`
+	Namespace   types.String  `tfsdk:"namespace"`
+	Name        types.String  `tfsdk:"name"`
+	ConfigJSON  jsonStringVal `tfsdk:"config_json"`
 }
 
 type FireFlyContractListenerAPIModel struct {
@@ -90,6 +90,7 @@ func (r *firefly_contract_listenerResource) Schema(_ context.Context, _ resource
 				Description:   "Listener name (optional, auto-generated if not provided). Note: FireFly contract listeners are immutable - changes require replacement.",
 			},
 			"config_json": &schema.StringAttribute{
+				CustomType:    jsonStringType{},
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Description:   "JSON configuration for the listener (location, event, topic, options, etc.). Note: FireFly contract listeners are immutable - changes require replacement.",
@@ -189,7 +190,7 @@ func (r *firefly_contract_listenerResource) Read(ctx context.Context, req resour
 	}
 	configJSON, err := json.Marshal(configMap)
 	if err == nil {
-		data.ConfigJSON = types.StringValue(string(configJSON))
+		data.ConfigJSON = newJSONString(string(configJSON))
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -202,3 +203,7 @@ func (r *firefly_contract_listenerResource) Delete(ctx context.Context, req reso
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *firefly_contract_listenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
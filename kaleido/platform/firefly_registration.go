@@ -25,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
 
 type FireFlyRegistrationResourceModel struct {
@@ -38,8 +37,10 @@ type FireFlyRegistrationResourceModel struct {
 }
 
 type FireFlyStatusAPIModel struct {
-	Node FireFlyStatusNodeAPIModel `json:"node"`
-	Org  FireFlyStatusOrgAPIModel  `json:"org"`
+	Namespace  *FireFlyNamespaceAPIModel        `json:"namespace,omitempty"`
+	Node       FireFlyStatusNodeAPIModel        `json:"node"`
+	Org        FireFlyStatusOrgAPIModel         `json:"org"`
+	Multiparty *FireFlyStatusMultipartyAPIModel `json:"multiparty,omitempty"`
 }
 
 type FireFlyStatusOrgAPIModel struct {
@@ -61,6 +62,35 @@ type FireFlyStatusVerifierAPIModel struct {
 	Value string `json:"value"`
 }
 
+type FireFlyNamespaceAPIModel struct {
+	Name        string `json:"name"`
+	NetworkName string `json:"networkName,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type FireFlyStatusMultipartyAPIModel struct {
+	Enabled   bool                                      `json:"enabled"`
+	Contracts *FireFlyStatusMultipartyContractsAPIModel `json:"contracts,omitempty"`
+}
+
+type FireFlyStatusMultipartyContractsAPIModel struct {
+	Active     *FireFlyMultipartyContractAPIModel   `json:"active,omitempty"`
+	Terminated []*FireFlyMultipartyContractAPIModel `json:"terminated,omitempty"`
+}
+
+type FireFlyMultipartyContractAPIModel struct {
+	Index      int                                    `json:"index"`
+	FirstEvent string                                 `json:"firstEvent,omitempty"`
+	Location   interface{}                            `json:"location,omitempty"`
+	Info       *FireFlyMultipartyContractInfoAPIModel `json:"info,omitempty"`
+}
+
+type FireFlyMultipartyContractInfoAPIModel struct {
+	Subscription string `json:"subscription,omitempty"`
+	FinalEvent   string `json:"finalEvent,omitempty"`
+	Version      int    `json:"version,omitempty"`
+}
+
 func FireFlyRegistrationResourceFactory() resource.Resource {
 	return &firefly_registrationResource{}
 }
@@ -130,7 +160,7 @@ func (r *firefly_registrationResource) ensureRegistered(ctx context.Context, dat
 	nodeSubmitted := false
 	orgSubmitted := false
 	cancelInfo := APICancelInfo()
-	_ = kaleidobase.Retry.Do(ctx, "register", func(attempt int) (retry bool, err error) {
+	_ = r.retry().Do(ctx, "register", func(attempt int) (retry bool, err error) {
 		ok, statusCode := r.apiRequest(ctx, http.MethodGet, r.apiPath(data, "status"), nil, &status, diagnostics, cancelInfo)
 		if !ok {
 			if statusCode == 429 {
@@ -204,3 +234,7 @@ func (r *firefly_registrationResource) Read(ctx context.Context, req resource.Re
 func (r *firefly_registrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// no-op
 }
+
+func (r *firefly_registrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service")
+}
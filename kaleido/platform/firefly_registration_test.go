@@ -100,5 +100,7 @@ func (mp *mockPlatform) getFireFlyStatus(res http.ResponseWriter, _ *http.Reques
 	if mp.ffsOrg != nil {
 		status.Org = *mp.ffsOrg
 	}
+	status.Namespace = mp.ffsNamespace
+	status.Multiparty = mp.ffsMultiparty
 	mp.respond(res, &status, 200)
 }
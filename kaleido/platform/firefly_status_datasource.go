@@ -0,0 +1,269 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type FireFlyStatusDatasourceModel struct {
+	Environment         types.String                               `tfsdk:"environment"`
+	Service             types.String                               `tfsdk:"service"`
+	Namespace           types.String                               `tfsdk:"namespace"`
+	Namespaces          []FireFlyNamespaceDatasourceModel          `tfsdk:"namespaces"`
+	NetworkName         types.String                               `tfsdk:"network_name"`
+	OrgName             types.String                               `tfsdk:"org_name"`
+	OrgRegistered       types.Bool                                 `tfsdk:"org_registered"`
+	OrgID               types.String                               `tfsdk:"org_id"`
+	OrgDID              types.String                               `tfsdk:"org_did"`
+	OrgVerifiers        []FireFlyVerifierDatasourceModel           `tfsdk:"org_verifiers"`
+	NodeName            types.String                               `tfsdk:"node_name"`
+	NodeRegistered      types.Bool                                 `tfsdk:"node_registered"`
+	NodeID              types.String                               `tfsdk:"node_id"`
+	MultipartyEnabled   types.Bool                                 `tfsdk:"multiparty_enabled"`
+	MultipartyContracts []FireFlyMultipartyContractDatasourceModel `tfsdk:"multiparty_contracts"`
+}
+
+type FireFlyNamespaceDatasourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	NetworkName types.String `tfsdk:"network_name"`
+	Description types.String `tfsdk:"description"`
+}
+
+type FireFlyVerifierDatasourceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type FireFlyMultipartyContractDatasourceModel struct {
+	Index        types.Int64   `tfsdk:"index"`
+	Active       types.Bool    `tfsdk:"active"`
+	LocationJSON jsonStringVal `tfsdk:"location_json"`
+	FirstEvent   types.String  `tfsdk:"first_event"`
+	Version      types.Int64   `tfsdk:"version"`
+}
+
+func (api *FireFlyMultipartyContractAPIModel) toDatasourceData(active bool, diagnostics *diag.Diagnostics) FireFlyMultipartyContractDatasourceModel {
+	data := FireFlyMultipartyContractDatasourceModel{
+		Index:        types.Int64Value(int64(api.Index)),
+		Active:       types.BoolValue(active),
+		LocationJSON: jsonStringNull(),
+		FirstEvent:   types.StringValue(api.FirstEvent),
+		Version:      types.Int64Value(0),
+	}
+	if api.Location != nil {
+		b, err := json.Marshal(api.Location)
+		if err != nil {
+			diagnostics.AddError("failed to marshal contract location", err.Error())
+		} else {
+			data.LocationJSON = newJSONString(string(b))
+		}
+	}
+	if api.Info != nil {
+		data.Version = types.Int64Value(int64(api.Info.Version))
+	}
+	return data
+}
+
+func (api *FireFlyStatusAPIModel) toDatasourceData(data *FireFlyStatusDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.NetworkName = types.StringValue("")
+	if api.Namespace != nil {
+		data.Namespace = types.StringValue(api.Namespace.Name)
+		data.NetworkName = types.StringValue(api.Namespace.NetworkName)
+	}
+	data.OrgName = types.StringValue(api.Org.Name)
+	data.OrgRegistered = types.BoolValue(api.Org.Registered)
+	data.OrgID = types.StringValue(api.Org.ID)
+	data.OrgDID = types.StringValue(api.Org.DID)
+	data.OrgVerifiers = make([]FireFlyVerifierDatasourceModel, len(api.Org.Verifiers))
+	for i, v := range api.Org.Verifiers {
+		data.OrgVerifiers[i] = FireFlyVerifierDatasourceModel{
+			Type:  types.StringValue(v.Type),
+			Value: types.StringValue(v.Value),
+		}
+	}
+	data.NodeName = types.StringValue(api.Node.Name)
+	data.NodeRegistered = types.BoolValue(api.Node.Registered)
+	data.NodeID = types.StringValue(api.Node.ID)
+	data.MultipartyEnabled = types.BoolValue(api.Multiparty != nil && api.Multiparty.Enabled)
+	data.MultipartyContracts = []FireFlyMultipartyContractDatasourceModel{}
+	if api.Multiparty != nil && api.Multiparty.Contracts != nil {
+		if api.Multiparty.Contracts.Active != nil {
+			data.MultipartyContracts = append(data.MultipartyContracts, api.Multiparty.Contracts.Active.toDatasourceData(true, diagnostics))
+		}
+		for _, c := range api.Multiparty.Contracts.Terminated {
+			data.MultipartyContracts = append(data.MultipartyContracts, c.toDatasourceData(false, diagnostics))
+		}
+	}
+}
+
+func FireFlyStatusDatasourceModelFactory() datasource.DataSource {
+	return &firefly_statusDatasource{}
+}
+
+type firefly_statusDatasource struct {
+	commonDataSource
+}
+
+func (r *firefly_statusDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_firefly_status"
+}
+
+func (r *firefly_statusDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Read the status of a FireFly namespace, including its registered organization and node identities and multiparty contracts. Unlike `kaleido_platform_firefly_registration`, this does not register the namespace.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "FireFly Service ID",
+			},
+			"namespace": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Namespace to read the status of. Defaults to the default namespace of the service",
+			},
+			"namespaces": &schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All namespaces of the service",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"network_name": &schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the namespace in the multiparty network",
+						},
+						"description": &schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"network_name": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the namespace in the multiparty network",
+			},
+			"org_name": &schema.StringAttribute{
+				Computed: true,
+			},
+			"org_registered": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"org_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"org_did": &schema.StringAttribute{
+				Computed: true,
+			},
+			"org_verifiers": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"value": &schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"node_name": &schema.StringAttribute{
+				Computed: true,
+			},
+			"node_registered": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"node_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"multiparty_enabled": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"multiparty_contracts": &schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Multiparty contracts of the namespace, with the active contract first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": &schema.Int64Attribute{
+							Computed: true,
+						},
+						"active": &schema.BoolAttribute{
+							Computed: true,
+						},
+						"location_json": &schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsonStringType{},
+							Description: "Location of the contract on the blockchain, such as `{\"address\":\"0x...\"}`",
+						},
+						"first_event": &schema.StringAttribute{
+							Computed: true,
+						},
+						"version": &schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *firefly_statusDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FireFlyStatusDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	basePath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1", data.Environment.ValueString(), data.Service.ValueString())
+	var namespaces []*FireFlyNamespaceAPIModel
+	if ok, _ := r.apiRequest(ctx, http.MethodGet, basePath+"/namespaces", nil, &namespaces, &resp.Diagnostics); !ok {
+		return
+	}
+	data.Namespaces = make([]FireFlyNamespaceDatasourceModel, len(namespaces))
+	for i, ns := range namespaces {
+		data.Namespaces[i] = FireFlyNamespaceDatasourceModel{
+			Name:        types.StringValue(ns.Name),
+			NetworkName: types.StringValue(ns.NetworkName),
+			Description: types.StringValue(ns.Description),
+		}
+	}
+
+	statusPath := basePath + "/status"
+	if data.Namespace.ValueString() != "" {
+		statusPath = fmt.Sprintf("%s/namespaces/%s/status", basePath, url.PathEscape(data.Namespace.ValueString()))
+	}
+	var status FireFlyStatusAPIModel
+	if ok, _ := r.apiRequest(ctx, http.MethodGet, statusPath, nil, &status, &resp.Diagnostics); !ok {
+		return
+	}
+
+	status.toDatasourceData(&data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,114 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var fireflyStatusDataStep1 = `
+data "kaleido_platform_firefly_status" "ff1" {
+    environment = "env1"
+    service = "service1"
+    namespace = "ns1"
+}
+`
+
+func TestFireFlyStatusData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.fireflyNamespaces = []*FireFlyNamespaceAPIModel{
+		{Name: "default", NetworkName: "default"},
+		{Name: "ns1", NetworkName: "network1", Description: "Consortium namespace"},
+	}
+	mp.ffsNamespace = &FireFlyNamespaceAPIModel{Name: "ns1", NetworkName: "network1"}
+	mp.ffsNode = &FireFlyStatusNodeAPIModel{Name: "node1", Registered: true, ID: "node1_id"}
+	mp.ffsOrg = &FireFlyStatusOrgAPIModel{
+		Name:       "org1",
+		Registered: true,
+		ID:         "org1_id",
+		DID:        "did:firefly:org/org1",
+		Verifiers: []FireFlyStatusVerifierAPIModel{
+			{Type: "ethereum_address", Value: "0x1111111111111111111111111111111111111111"},
+		},
+	}
+	mp.ffsMultiparty = &FireFlyStatusMultipartyAPIModel{
+		Enabled: true,
+		Contracts: &FireFlyStatusMultipartyContractsAPIModel{
+			Active: &FireFlyMultipartyContractAPIModel{
+				Index:      1,
+				FirstEvent: "newest",
+				Location:   map[string]interface{}{"address": "0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a"},
+				Info:       &FireFlyMultipartyContractInfoAPIModel{Version: 2},
+			},
+			Terminated: []*FireFlyMultipartyContractAPIModel{
+				{
+					Index:      0,
+					FirstEvent: "oldest",
+					Location:   map[string]interface{}{"address": "0x2222222222222222222222222222222222222222"},
+					Info:       &FireFlyMultipartyContractInfoAPIModel{Version: 1, FinalEvent: "1000"},
+				},
+			},
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/namespaces",
+			"GET /endpoint/{env}/{service}/rest/api/v1/namespaces/{ns}/status",
+			"GET /endpoint/{env}/{service}/rest/api/v1/namespaces",
+			"GET /endpoint/{env}/{service}/rest/api/v1/namespaces/{ns}/status",
+			"GET /endpoint/{env}/{service}/rest/api/v1/namespaces",
+			"GET /endpoint/{env}/{service}/rest/api/v1/namespaces/{ns}/status",
+		})
+		mp.server.Close()
+	}()
+
+	ffData := "data.kaleido_platform_firefly_status.ff1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fireflyStatusDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(ffData, "namespaces.#", "2"),
+					resource.TestCheckResourceAttr(ffData, "namespaces.1.description", "Consortium namespace"),
+					resource.TestCheckResourceAttr(ffData, "network_name", "network1"),
+					resource.TestCheckResourceAttr(ffData, "org_registered", "true"),
+					resource.TestCheckResourceAttr(ffData, "org_did", "did:firefly:org/org1"),
+					resource.TestCheckResourceAttr(ffData, "org_verifiers.0.type", "ethereum_address"),
+					resource.TestCheckResourceAttr(ffData, "org_verifiers.0.value", "0x1111111111111111111111111111111111111111"),
+					resource.TestCheckResourceAttr(ffData, "node_id", "node1_id"),
+					resource.TestCheckResourceAttr(ffData, "multiparty_enabled", "true"),
+					resource.TestCheckResourceAttr(ffData, "multiparty_contracts.#", "2"),
+					resource.TestCheckResourceAttr(ffData, "multiparty_contracts.0.active", "true"),
+					resource.TestCheckResourceAttr(ffData, "multiparty_contracts.0.location_json", `{"address":"0x3a3f6d4d8e4b2c1a0f9e8d7c6b5a4f3e2d1c0b9a"}`),
+					resource.TestCheckResourceAttr(ffData, "multiparty_contracts.0.version", "2"),
+					resource.TestCheckResourceAttr(ffData, "multiparty_contracts.1.active", "false"),
+					resource.TestCheckResourceAttr(ffData, "multiparty_contracts.1.first_event", "oldest"),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listFireFlyNamespaces(res http.ResponseWriter, _ *http.Request) {
+	namespaces := mp.fireflyNamespaces
+	if namespaces == nil {
+		namespaces = []*FireFlyNamespaceAPIModel{}
+	}
+	mp.respond(res, &namespaces, 200)
+}
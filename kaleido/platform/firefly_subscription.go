@@ -29,12 +29,12 @@ import (
 )
 
 type FireFlySubscriptionResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Environment types.String `tfsdk:"environment"`
-	Service     types.String `tfsdk:"service"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Name        types.String `tfsdk:"name"`
-	ConfigJSON  types.String `tfsdk:"config_json"`
+	ID          types.String  `tfsdk:"id"`
+	Environment types.String  `tfsdk:"environment"`
+	Service     types.String  `tfsdk:"service"`
+	Namespace   types.String  `tfsdk:"namespace"`
+	Name        types.String  `tfsdk:"name"`
+	ConfigJSON  jsonStringVal `tfsdk:"config_json"`
 }
 
 type FireFlySubscriptionAPIModel struct {
@@ -105,6 +105,7 @@ func (r *firefly_subscriptionResource) Schema(_ context.Context, _ resource.Sche
 				Description:   "Subscription name",
 			},
 			"config_json": &schema.StringAttribute{
+				CustomType:    jsonStringType{},
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Description:   "JSON configuration for the subscription (transport, filter, options). For webhook subscriptions, webhook-specific fields like 'url', 'method', 'headers', 'query', 'tlsConfigName', 'retry', and 'httpOptions' should be set directly in the 'options' object. See https://hyperledger.github.io/firefly/latest/reference/types/subscription/ for the full schema. Note: FireFly subscriptions are immutable - changes require replacement.",
@@ -206,7 +207,7 @@ func (r *firefly_subscriptionResource) Read(ctx context.Context, req resource.Re
 	}
 	configJSON, err := json.Marshal(configMap)
 	if err == nil {
-		data.ConfigJSON = types.StringValue(string(configJSON))
+		data.ConfigJSON = newJSONString(string(configJSON))
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -219,3 +220,7 @@ func (r *firefly_subscriptionResource) Delete(ctx context.Context, req resource.
 
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *firefly_subscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -0,0 +1,121 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GroupDatasourceModel struct {
+	ID      types.String                 `tfsdk:"id"`
+	Name    types.String                 `tfsdk:"name"`
+	Members []GroupMemberDatasourceModel `tfsdk:"members"`
+}
+
+type GroupMemberDatasourceModel struct {
+	MembershipID types.String `tfsdk:"membership_id"`
+	UserID       types.String `tfsdk:"user_id"`
+	UserName     types.String `tfsdk:"user_name"`
+}
+
+func GroupDatasourceModelFactory() datasource.DataSource {
+	return &groupDatasource{}
+}
+
+type groupDatasource struct {
+	commonDataSource
+}
+
+func (r *groupDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_group"
+}
+
+func (r *groupDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing group in the account by name or ID, with its members.",
+		Attributes: map[string]schema.Attribute{
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Group ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Group Name. Exactly one of `id` or `name` must be set",
+			},
+			"members": &schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users that are members of the group",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"membership_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"user_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"user_name": &schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *groupDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *groupDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api GroupAPIModel
+	if !lookupByNameOrID(ctx, &r.commonDataSource, "/api/v1/groups", "group", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+
+	membersPath := fmt.Sprintf("/api/v1/groups/%s/members", url.PathEscape(api.ID))
+	members, ok := listAll[GroupMembershipAPIModel](ctx, &r.commonDataSource, membersPath, url.Values{}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	data.Members = make([]GroupMemberDatasourceModel, len(members))
+	for i, m := range members {
+		data.Members[i] = GroupMemberDatasourceModel{
+			MembershipID: types.StringValue(m.ID),
+			UserID:       types.StringValue(m.UserID),
+			UserName:     types.StringValue(m.UserName),
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,74 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var groupDataStep1 = `
+data "kaleido_platform_group" "admins" {
+    name = "admins"
+}
+`
+
+func TestGroupData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.groups["group1"] = &GroupAPIModel{ID: "group1", Name: "admins"}
+	mp.groups["group2"] = &GroupAPIModel{ID: "group2", Name: "admins-readonly"}
+	mp.groupMembers["group1/m1"] = &GroupMembershipAPIModel{ID: "m1", GroupID: "group1", UserID: "user1", UserName: "User One"}
+	mp.groupMembers["group1/m2"] = &GroupMembershipAPIModel{ID: "m2", GroupID: "group1", UserID: "user2", UserName: "User Two"}
+	mp.groupMembers["group2/m3"] = &GroupMembershipAPIModel{ID: "m3", GroupID: "group2", UserID: "user3", UserName: "User Three"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/groups",
+			"GET /api/v1/groups/{group}/members",
+			"GET /api/v1/groups",
+			"GET /api/v1/groups/{group}/members",
+			"GET /api/v1/groups",
+			"GET /api/v1/groups/{group}/members",
+		})
+		mp.server.Close()
+	}()
+
+	groupData := "data.kaleido_platform_group.admins"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + groupDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(groupData, "id", "group1"),
+					resource.TestCheckResourceAttr(groupData, "members.#", "2"),
+					resource.TestCheckResourceAttr(groupData, "members.0.membership_id", "m1"),
+					resource.TestCheckResourceAttr(groupData, "members.0.user_id", "user1"),
+					resource.TestCheckResourceAttr(groupData, "members.1.user_name", "User Two"),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listGroups(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.groups, "")
+}
+
+func (mp *mockPlatform) listGroupMembers(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.groupMembers, mux.Vars(req)["group"]+"/")
+}
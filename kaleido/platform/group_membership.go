@@ -195,5 +195,5 @@ func (r *groupMembershipResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *groupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.AddError("Import not supported", "Import is not currentlysupported for group memberships")
+	importStateFromID(ctx, req, resp, "group_id", "user_id")
 }
@@ -18,11 +18,15 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +35,8 @@ type IdentityProviderResourceModel struct {
 	Name                    types.String `tfsdk:"name"`
 	ClientID                types.String `tfsdk:"client_id"`
 	ClientSecret            types.String `tfsdk:"client_secret"`
+	ClientSecretWO          types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion   types.Int64  `tfsdk:"client_secret_wo_version"`
 	ClientType              types.String `tfsdk:"client_type"`
 	Hostname                types.String `tfsdk:"hostname"`
 	Issuer                  types.String `tfsdk:"issuer"`
@@ -103,6 +109,14 @@ func (r *identityProviderResource) Schema(_ context.Context, _ resource.SchemaRe
 				Sensitive:   true,
 				Description: "OAuth client secret (required for confidential clients)",
 			},
+			"client_secret_wo": &schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("client_secret"))},
+				Description: "Write-only alternative to `client_secret`, that is never stored in state. Requires Terraform 1.11 or later. The secret is sent whenever the identity provider is created or updated, so change `client_secret_wo_version` to update it.",
+			},
+			"client_secret_wo_version": writeOnlyVersionAttribute("client_secret_wo"),
 			"client_type": &schema.StringAttribute{
 				Required:    true,
 				Description: "The OAuth client type: 'public' or 'confidential'",
@@ -257,6 +271,18 @@ func (api *IdentityProviderAPIModel) toData(data *IdentityProviderResourceModel)
 	}
 }
 
+// clientSecretFromConfig sets the client secret sent to the API from client_secret_wo, if configured.
+// Write-only values are only available in the config, and are null in the plan and state.
+func (r *identityProviderResource) clientSecretFromConfig(ctx context.Context, config tfsdk.Config, api *IdentityProviderAPIModel, diagnostics *diag.Diagnostics) bool {
+	var clientSecret types.String
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("client_secret_wo"), &clientSecret)...)
+	if clientSecret.IsNull() || clientSecret.IsUnknown() {
+		return false
+	}
+	api.ClientSecret = clientSecret.ValueString()
+	return true
+}
+
 func (r *identityProviderResource) apiPath(data *IdentityProviderResourceModel) string {
 	path := "/api/v1/oidc-clients"
 	if data.ID.ValueString() != "" {
@@ -271,12 +297,20 @@ func (r *identityProviderResource) Create(ctx context.Context, req resource.Crea
 
 	var api IdentityProviderAPIModel
 	data.toAPI(&api)
+	writeOnlySecret := r.clientSecretFromConfig(ctx, req.Config, &api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics)
 	if !ok {
 		return
 	}
 
 	api.toData(&data)
+	if writeOnlySecret {
+		// Never store a write-only secret in state, even if it is returned by the API
+		data.ClientSecret = types.StringNull()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -293,11 +327,18 @@ func (r *identityProviderResource) Update(ctx context.Context, req resource.Upda
 
 	// Update from plan
 	data.toAPI(&api)
+	writeOnlySecret := r.clientSecretFromConfig(ctx, req.Config, &api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if ok, _ := r.apiRequest(ctx, http.MethodPatch, r.apiPath(&data), api, &api, &resp.Diagnostics); !ok {
 		return
 	}
 
 	api.toData(&data)
+	if writeOnlySecret {
+		data.ClientSecret = types.StringNull()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -0,0 +1,224 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// jsonNumber is an exact representation of a JSON number, so that 2, 2.0 and 2e0 compare
+// as equal without losing precision on large integers such as uint256 values.
+type jsonNumber string
+
+// stripJSONNulls recursively removes keys whose value is nil from maps,
+// and nil entries from slices. Returned slices/maps are new copies; scalar
+// values are returned unchanged.
+func stripJSONNulls(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, val := range t {
+			if val == nil {
+				continue
+			}
+			out[k] = stripJSONNulls(val)
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(t))
+		for _, val := range t {
+			if val == nil {
+				continue
+			}
+			out = append(out, stripJSONNulls(val))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func normalizeJSONNumbers(v any) (any, error) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			n, err := normalizeJSONNumbers(val)
+			if err != nil {
+				return nil, err
+			}
+			t[k] = n
+		}
+		return t, nil
+	case []any:
+		for i, val := range t {
+			n, err := normalizeJSONNumbers(val)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = n
+		}
+		return t, nil
+	case json.Number:
+		r, ok := new(big.Rat).SetString(t.String())
+		if !ok {
+			return nil, fmt.Errorf("invalid number '%s'", t)
+		}
+		return jsonNumber(r.RatString()), nil
+	default:
+		return v, nil
+	}
+}
+
+// normalizeJSON decodes a JSON document into a form that can be compared with reflect.DeepEqual,
+// optionally with null fields and array entries removed.
+func normalizeJSON(s string, stripNulls bool) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the end of the JSON value")
+	}
+	if stripNulls {
+		v = stripJSONNulls(v)
+	}
+	return normalizeJSONNumbers(v)
+}
+
+// jsonSemanticEqual compares two JSON documents ignoring key order, whitespace and number
+// formatting. Strings that are not valid JSON are only equal if they are identical.
+func jsonSemanticEqual(a, b string, stripNulls bool) bool {
+	va, errA := normalizeJSON(a, stripNulls)
+	vb, errB := normalizeJSON(b, stripNulls)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// jsonStringType is a Terraform Plugin Framework custom string type for attributes
+// holding a JSON document. Semantic equality compares the decoded documents, so
+// a value the provider writes back with different key order, whitespace or number
+// formatting to the planned value does not show as a change.
+//
+// With stripNulls set, null fields are also ignored. This lets the schema attribute
+// remain Required while accepting a planned value (e.g. jsonencode of a typed object
+// with unset optional() leaves) that the provider stores in a null-stripped
+// canonical form — without triggering Terraform's "planned value does not
+// match config value" or "inconsistent result after apply" guards.
+type jsonStringType struct {
+	basetypes.StringType
+	stripNulls bool
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.stripNulls == other.stripNulls && t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	if t.stripNulls {
+		return "jsonStringType(stripNulls)"
+	}
+	return "jsonStringType"
+}
+
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonStringVal{stripNulls: t.stripNulls}
+}
+
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonStringVal{StringValue: in, stripNulls: t.stripNulls}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T returned by StringType.ValueFromTerraform", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+type jsonStringVal struct {
+	basetypes.StringValue
+	stripNulls bool
+}
+
+func newJSONString(s string) jsonStringVal {
+	return jsonStringVal{StringValue: types.StringValue(s)}
+}
+
+func newJSONNullStrippedString(s string) jsonStringVal {
+	return jsonStringVal{StringValue: types.StringValue(s), stripNulls: true}
+}
+
+func jsonStringNull() jsonStringVal {
+	return jsonStringVal{StringValue: types.StringNull()}
+}
+
+func (v jsonStringVal) Type(_ context.Context) attr.Type {
+	return jsonStringType{stripNulls: v.stripNulls}
+}
+
+func (v jsonStringVal) Equal(o attr.Value) bool {
+	other, ok := o.(jsonStringVal)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonStringVal) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(jsonStringVal)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("expected value type %T, got %T", v, newValuable),
+		)
+		return false, diags
+	}
+	if v.IsNull() != newValue.IsNull() || v.IsUnknown() != newValue.IsUnknown() {
+		return false, diags
+	}
+	if v.IsNull() || v.IsUnknown() {
+		return true, diags
+	}
+	return jsonSemanticEqual(v.ValueString(), newValue.ValueString(), v.stripNulls), diags
+}
@@ -17,7 +17,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -76,32 +75,90 @@ func TestStripJSONNulls(t *testing.T) {
 	}
 }
 
-func TestCanonicalizeStrippedJSON_Equal(t *testing.T) {
+func TestJSONSemanticEqualStripNulls(t *testing.T) {
 	// Planned (typed-object jsonencode output) vs stored (server round-trip)
 	planned := `{"format":null,"source":{"fixedGasPrice":{"enabled":true,"maxFeePerGas":"0x0","maxPriorityFeePerGas":"0x0","gasPrice":null},"gasOracleAPI":null,"RPCEndpoint":null},"autoIncrement":null,"caps":null}`
 	stored := `{"source":{"fixedGasPrice":{"enabled":true,"maxFeePerGas":"0x0","maxPriorityFeePerGas":"0x0"}}}`
+	assert.True(t, jsonSemanticEqual(planned, stored, true), "null-stripped forms should match")
+	assert.False(t, jsonSemanticEqual(planned, stored, false), "nulls are significant unless stripped")
 
-	a, err := canonicalizeStrippedJSON(planned)
-	require.NoError(t, err)
-	b, err := canonicalizeStrippedJSON(stored)
-	require.NoError(t, err)
-	assert.Equal(t, a, b, "null-stripped canonical forms should match")
+	assert.False(t, jsonSemanticEqual(`{"count":3}`, `{"count":2}`, true))
 }
 
-func TestCanonicalizeStrippedJSON_Differ(t *testing.T) {
-	planned := `{"count":3}`
-	stored := `{"count":2}`
+func TestJSONSemanticEqualInvalidJSON(t *testing.T) {
+	_, err := normalizeJSON("not json", false)
+	assert.Error(t, err)
+	_, err = normalizeJSON(`{"a":1} {"b":2}`, false)
+	assert.Regexp(t, "unexpected data", err)
 
-	a, err := canonicalizeStrippedJSON(planned)
-	require.NoError(t, err)
-	b, err := canonicalizeStrippedJSON(stored)
-	require.NoError(t, err)
-	assert.NotEqual(t, a, b)
+	// Falls back to a string comparison
+	assert.True(t, jsonSemanticEqual("not json", "not json", false))
+	assert.False(t, jsonSemanticEqual("not json", "not  json", false))
 }
 
-func TestCanonicalizeStrippedJSON_InvalidJSON(t *testing.T) {
-	_, err := canonicalizeStrippedJSON("not json")
-	assert.Error(t, err)
+func TestJSONStringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{
+			name: "key order",
+			a:    `{"a":1,"b":{"c":true,"d":"x"}}`,
+			b:    `{"b":{"d":"x","c":true},"a":1}`,
+			want: true,
+		},
+		{
+			name: "whitespace",
+			a:    "{\n  \"a\": [1, 2, 3]\n}\n",
+			b:    `{"a":[1,2,3]}`,
+			want: true,
+		},
+		{
+			name: "array order is significant",
+			a:    `{"a":[1,2,3]}`,
+			b:    `{"a":[3,2,1]}`,
+			want: false,
+		},
+		{
+			name: "number formatting",
+			a:    `{"a":2,"b":1000,"c":0.5}`,
+			b:    `{"a":2.0,"b":1e3,"c":5E-1}`,
+			want: true,
+		},
+		{
+			name: "large integers compared exactly",
+			a:    `{"supply":115792089237316195423570985008687907853269984665640564039457584007913129639935}`,
+			b:    `{"supply":115792089237316195423570985008687907853269984665640564039457584007913129639934}`,
+			want: false,
+		},
+		{
+			name: "number is not equal to string",
+			a:    `{"a":1}`,
+			b:    `{"a":"1"}`,
+			want: false,
+		},
+		{
+			name: "nested nulls are significant",
+			a:    `{"a":{"b":null,"c":[null,1]}}`,
+			b:    `{"a":{"c":[null,1]}}`,
+			want: false,
+		},
+		{
+			name: "nested nulls in both",
+			a:    `{"a":{"b":null,"c":[null,1]}}`,
+			b:    `{"a":{"c":[null,1.0],"b":null}}`,
+			want: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eq, diags := newJSONString(tc.a).StringSemanticEquals(ctx, newJSONString(tc.b))
+			require.False(t, diags.HasError(), "diagnostics: %+v", diags)
+			assert.Equal(t, tc.want, eq)
+		})
+	}
 }
 
 func TestJSONNullStrippedStringSemanticEquals(t *testing.T) {
@@ -135,6 +192,12 @@ func TestJSONNullStrippedStringSemanticEquals(t *testing.T) {
 			b:    `{"source":{"fixedGasPrice":{"enabled":true}}}`,
 			want: true,
 		},
+		{
+			name: "nested nulls stripped from arrays",
+			a:    `{"a":{"b":null,"c":[null,1]}}`,
+			b:    `{"a":{"c":[1]}}`,
+			want: true,
+		},
 		{
 			name: "both collapse to empty",
 			a:    `{"previousTxnsCondition":null}`,
@@ -144,25 +207,23 @@ func TestJSONNullStrippedStringSemanticEquals(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			va := jsonNullStrippedStringVal{StringValue: types.StringValue(tc.a)}
-			vb := jsonNullStrippedStringVal{StringValue: types.StringValue(tc.b)}
-			eq, diags := va.StringSemanticEquals(ctx, vb)
+			eq, diags := newJSONNullStrippedString(tc.a).StringSemanticEquals(ctx, newJSONNullStrippedString(tc.b))
 			require.False(t, diags.HasError(), "diagnostics: %+v", diags)
 			assert.Equal(t, tc.want, eq)
 		})
 	}
 }
 
-func TestJSONNullStrippedStringSemanticEquals_NullVsValue(t *testing.T) {
+func TestJSONStringSemanticEquals_NullVsValue(t *testing.T) {
 	ctx := context.Background()
-	null := jsonNullStrippedStringVal{StringValue: types.StringNull()}
-	val := jsonNullStrippedStringVal{StringValue: types.StringValue(`{}`)}
+	null := jsonStringNull()
+	val := newJSONString(`{}`)
 	eq, diags := null.StringSemanticEquals(ctx, val)
 	require.False(t, diags.HasError())
 	assert.False(t, eq)
 
 	// null == null
-	null2 := jsonNullStrippedStringVal{StringValue: types.StringNull()}
+	null2 := jsonStringNull()
 	eq, diags = null.StringSemanticEquals(ctx, null2)
 	require.False(t, diags.HasError())
 	assert.True(t, eq)
@@ -26,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
 
 type KMSKeyResourceModel struct {
@@ -42,25 +44,41 @@ type KMSKeyResourceModel struct {
 }
 
 type KMSKeyAPIModel struct {
-	ID                    string            `json:"id,omitempty"`
-	Created               *time.Time        `json:"created,omitempty"`
-	Updated               *time.Time        `json:"updated,omitempty"`
-	Name                  string            `json:"name"`
-	Path                  string            `json:"path,omitempty"`
-	URI                   string            `json:"uri,omitempty"`
-	Address               string            `json:"address,omitempty"`
-	Attributes            map[string]string `json:"attributes,omitempty"`
-	PublicIdentifierTypes []string          `json:"publicIdentifierTypes,omitempty"`
+	ID                    string                           `json:"id,omitempty"`
+	Created               *time.Time                       `json:"created,omitempty"`
+	Updated               *time.Time                       `json:"updated,omitempty"`
+	Name                  string                           `json:"name"`
+	Path                  string                           `json:"path,omitempty"`
+	URI                   string                           `json:"uri,omitempty"`
+	Address               string                           `json:"address,omitempty"`
+	Attributes            map[string]string                `json:"attributes,omitempty"`
+	PublicIdentifierTypes []string                         `json:"publicIdentifierTypes,omitempty"`
+	PublicIdentifiers     []KMSKeyPublicIdentifierAPIModel `json:"publicIdentifiers,omitempty"`
+}
+
+type KMSKeyPublicIdentifierAPIModel struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// KMS key operations complete in seconds, so poll more often than the default
+var kmsKeyRetry = kaleidobase.CustomRetry{
+	InitialDelay: 1 * time.Second,
+	MaximumDelay: 5 * time.Second,
+	Factor:       2.0,
 }
 
 func KMSKeyResourceFactory() resource.Resource {
-	return &kms_keyResource{}
+	return &kms_keyResource{commonResource{retryDefaults: &kmsKeyRetry}}
 }
 
 type kms_keyResource struct {
 	commonResource
 }
 
+// kmsKeyIdentity identifies a key by the attributes of its import ID
+var kmsKeyIdentity = resourceIdentity{"environment", "service", "wallet", "id"}
+
 func (r *kms_keyResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_kms_key"
 }
@@ -138,6 +156,7 @@ func (data *KMSKeyResourceModel) toAPI(ctx context.Context, api *KMSKeyAPIModel,
 
 func (api *KMSKeyAPIModel) toData(ctx context.Context, data *KMSKeyResourceModel, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
 	data.Path = types.StringValue(api.Path)
 	data.URI = types.StringValue(api.URI)
 	data.Address = types.StringValue(api.Address)
@@ -194,10 +213,12 @@ func (r *kms_keyResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	api.toData(ctx, &data, &resp.Diagnostics)
+
 	// Restore planned value into state
 	if !plannedPublicIdentifierTypes.IsNull() && !plannedPublicIdentifierTypes.IsUnknown() {
 		data.PublicIdentifierTypes = plannedPublicIdentifierTypes
 	}
+	kmsKeyIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.Wallet, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 }
@@ -232,6 +253,7 @@ func (r *kms_keyResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !plannedPublicIdentifierTypes.IsNull() && !plannedPublicIdentifierTypes.IsUnknown() {
 		data.PublicIdentifierTypes = plannedPublicIdentifierTypes
 	}
+	kmsKeyIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.Wallet, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -262,6 +284,7 @@ func (r *kms_keyResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if !currentPublicIdentifierTypes.IsNull() && !currentPublicIdentifierTypes.IsUnknown() {
 		data.PublicIdentifierTypes = currentPublicIdentifierTypes
 	}
+	kmsKeyIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.Wallet, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -277,3 +300,11 @@ func (r *kms_keyResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	r.waitForRemoval(ctx, apiPath, &resp.Diagnostics)
 }
+
+func (r *kms_keyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = kmsKeyIdentity.schema()
+}
+
+func (r *kms_keyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, kmsKeyIdentity...)
+}
@@ -0,0 +1,166 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KMSKeyDatasourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Environment       types.String `tfsdk:"environment"`
+	Service           types.String `tfsdk:"service"`
+	Wallet            types.String `tfsdk:"wallet"`
+	Name              types.String `tfsdk:"name"`
+	Path              types.String `tfsdk:"path"`
+	URI               types.String `tfsdk:"uri"`
+	Address           types.String `tfsdk:"address"`
+	Attributes        types.Map    `tfsdk:"attributes"`
+	PublicIdentifiers types.Map    `tfsdk:"public_identifiers"`
+}
+
+func (api *KMSKeyAPIModel) toDatasourceData(ctx context.Context, data *KMSKeyDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Path = types.StringValue(api.Path)
+	data.URI = types.StringValue(api.URI)
+	data.Address = types.StringValue(api.Address)
+	attributes := api.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	var d diag.Diagnostics
+	data.Attributes, d = types.MapValueFrom(ctx, types.StringType, attributes)
+	diagnostics.Append(d...)
+	publicIdentifiers := map[string]attr.Value{}
+	for _, pi := range api.PublicIdentifiers {
+		publicIdentifiers[pi.Type] = types.StringValue(pi.Value)
+	}
+	data.PublicIdentifiers, d = types.MapValue(types.StringType, publicIdentifiers)
+	diagnostics.Append(d...)
+}
+
+func KMSKeyDatasourceModelFactory() datasource.DataSource {
+	return &kms_keyDatasource{}
+}
+
+type kms_keyDatasource struct {
+	commonDataSource
+}
+
+func (r *kms_keyDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_kms_key"
+}
+
+func (r *kms_keyDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing signing key in a wallet by name, path, URI or ID, such as a key created by key discovery, and resolve its address.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Key Manager Service ID",
+			},
+			"wallet": &schema.StringAttribute{
+				Required:    true,
+				Description: "Wallet ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Key ID. Exactly one of `id`, `name`, `path` or `uri` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Key Display Name. Exactly one of `id`, `name`, `path` or `uri` must be set",
+			},
+			"path": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Path of the key material in the wallet. Exactly one of `id`, `name`, `path` or `uri` must be set",
+			},
+			"uri": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Key URI. Exactly one of `id`, `name`, `path` or `uri` must be set",
+			},
+			"address": &schema.StringAttribute{
+				Computed: true,
+			},
+			"attributes": &schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"public_identifiers": &schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Public identifiers of the key, keyed by type such as `eth_address`",
+			},
+		},
+	}
+}
+
+func (r *kms_keyDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name"), path.MatchRoot("path"), path.MatchRoot("uri")),
+	}
+}
+
+func (r *kms_keyDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KMSKeyDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// KMS requires that key operations are performed using the NAME of the wallet, not the ID
+	var wallet KMSWalletAPIModel
+	walletPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/wallets/%s", data.Environment.ValueString(), data.Service.ValueString(), url.PathEscape(data.Wallet.ValueString()))
+	if ok, _ := r.apiRequest(ctx, http.MethodGet, walletPath, nil, &wallet, &resp.Diagnostics); !ok {
+		return
+	}
+	keysPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/wallets/%s/keys", data.Environment.ValueString(), data.Service.ValueString(), url.PathEscape(wallet.Name))
+
+	var api KMSKeyAPIModel
+	var ok bool
+	switch {
+	case data.Path.ValueString() != "":
+		ok = lookupByField(ctx, &r.commonDataSource, keysPath, "key", "path", "path", data.Path.ValueString(), &api, &resp.Diagnostics)
+	case data.URI.ValueString() != "":
+		ok = lookupByField(ctx, &r.commonDataSource, keysPath, "key", "uri", "uri", data.URI.ValueString(), &api, &resp.Diagnostics)
+	default:
+		ok = lookupByNameOrID(ctx, &r.commonDataSource, keysPath, "key", data.ID, data.Name, &api, &resp.Diagnostics)
+	}
+	if !ok {
+		return
+	}
+
+	api.toDatasourceData(ctx, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
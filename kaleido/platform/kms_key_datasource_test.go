@@ -0,0 +1,112 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var kmsKeyDataStep1 = `
+data "kaleido_platform_kms_key" "key1" {
+    environment = "env1"
+    service = "service1"
+    wallet = "wallet1_id"
+    path = "m/44'/60'/0'/0/0"
+}
+`
+
+var kmsKeyDataStep2 = `
+data "kaleido_platform_kms_key" "key1" {
+    environment = "env1"
+    service = "service1"
+    wallet = "wallet1_id"
+    name = "key1"
+    path = "m/44'/60'/0'/0/0"
+}
+`
+
+func TestKMSKeyData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.kmsWallets["env1/service1/wallet1_id"] = &KMSWalletAPIModel{ID: "wallet1_id", Name: "wallet1"}
+	mp.kmsKeys["env1/service1/wallet1/key1_id"] = &KMSKeyAPIModel{
+		ID:         "key1_id",
+		Name:       "key1",
+		Path:       "m/44'/60'/0'/0/0",
+		URI:        "hdwallet://wallet1/m/44'/60'/0'/0/0",
+		Address:    "0x93976ab4d2b3b2a6e1a5e6b9c0a8c6a6b8a8d3e1",
+		Attributes: map[string]string{"attribute1": "value1"},
+		PublicIdentifiers: []KMSKeyPublicIdentifierAPIModel{
+			{Type: "eth_address", Value: "0x93976ab4d2b3b2a6e1a5e6b9c0a8c6a6b8a8d3e1"},
+		},
+	}
+	mp.kmsKeys["env1/service1/wallet1/key2_id"] = &KMSKeyAPIModel{ID: "key2_id", Name: "key2", Path: "m/44'/60'/0'/0/1"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys",
+		})
+		mp.server.Close()
+	}()
+
+	keyData := "data.kaleido_platform_kms_key.key1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + kmsKeyDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(keyData, "id", "key1_id"),
+					resource.TestCheckResourceAttr(keyData, "name", "key1"),
+					resource.TestCheckResourceAttr(keyData, "uri", "hdwallet://wallet1/m/44'/60'/0'/0/0"),
+					resource.TestCheckResourceAttr(keyData, "address", "0x93976ab4d2b3b2a6e1a5e6b9c0a8c6a6b8a8d3e1"),
+					resource.TestCheckResourceAttr(keyData, "attributes.attribute1", "value1"),
+					resource.TestCheckResourceAttr(keyData, "public_identifiers.eth_address", "0x93976ab4d2b3b2a6e1a5e6b9c0a8c6a6b8a8d3e1"),
+				),
+			},
+		},
+	})
+}
+
+func TestKMSKeyDataConflictingLookup(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + kmsKeyDataStep2,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listKMSKeys(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.kmsKeys, mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/"+mux.Vars(req)["wallet"]+"/")
+}
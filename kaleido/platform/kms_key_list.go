@@ -0,0 +1,89 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KMSKeyListModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Service     types.String `tfsdk:"service"`
+	Wallet      types.String `tfsdk:"wallet"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+}
+
+func KMSKeyListResourceFactory() list.ListResource {
+	return &kms_keyResource{}
+}
+
+func (r *kms_keyResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "List the keys in a Key Manager wallet, optionally filtered by name prefix.",
+		Attributes: map[string]listschema.Attribute{
+			"environment": listschema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": listschema.StringAttribute{
+				Required:    true,
+				Description: "Key Manager Service ID",
+			},
+			"wallet": listschema.StringAttribute{
+				Required:    true,
+				Description: "Wallet ID",
+			},
+			"name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list keys with a name starting with this prefix",
+			},
+		},
+	}
+}
+
+func (r *kms_keyResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config KMSKeyListModel
+	diagnostics := req.Config.Get(ctx, &config)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	// The keys are listed under the wallet name, which apiPath looks up from the wallet ID
+	collectionPath, ok := r.apiPath(ctx, &KMSKeyResourceModel{
+		Environment: config.Environment,
+		Service:     config.Service,
+		Wallet:      config.Wallet,
+	}, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	keys, ok := listAll[KMSKeyAPIModel](ctx, r, collectionPath, url.Values{}, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	keys = filterByNamePrefix(keys, config.NamePrefix, func(v *KMSKeyAPIModel) string { return v.Name })
+
+	stream.Results = listResults(ctx, req, kmsKeyIdentity, []types.String{config.Environment, config.Service, config.Wallet}, keys, func(api *KMSKeyAPIModel, data *KMSKeyResourceModel, diagnostics *diag.Diagnostics) {
+		api.toData(ctx, data, diagnostics)
+	})
+}
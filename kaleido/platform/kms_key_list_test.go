@@ -0,0 +1,77 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKMSKeyList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.kmsWallets["env1/service1/wallet1_id"] = &KMSWalletAPIModel{ID: "wallet1_id", Name: "wallet1"}
+	mp.kmsKeys["env1/service1/wallet1/key1_id"] = &KMSKeyAPIModel{ID: "key1_id", Name: "key1", Path: "m/44'/60'/0'/0/0", Address: "0x93976ab88e0aff11f5e2d0eb6a8ab1e09823e16e"}
+	mp.kmsKeys["env1/service1/wallet1/key2_id"] = &KMSKeyAPIModel{ID: "key2_id", Name: "key2", Path: "m/44'/60'/0'/0/1"}
+	mp.kmsKeys["env1/service1/wallet2/key3_id"] = &KMSKeyAPIModel{ID: "key3_id", Name: "key3"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, KMSKeyListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"service":     tftypes.NewValue(tftypes.String, "service1"),
+		"wallet":      tftypes.NewValue(tftypes.String, "wallet1_id"),
+	}, true, 0)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "key1", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "wallet": "wallet1_id", "id": "key1_id"}, testListResultAttrs(t, results[0].Identity, "environment", "service", "wallet", "id"))
+	assert.Equal(t, map[string]string{
+		"wallet":  "wallet1_id",
+		"name":    "key1",
+		"path":    "m/44'/60'/0'/0/0",
+		"address": "0x93976ab88e0aff11f5e2d0eb6a8ab1e09823e16e",
+	}, testListResultAttrs(t, results[0].Resource, "wallet", "name", "path", "address"))
+	assert.Equal(t, "key2", results[1].DisplayName)
+	mp.checkClearCalls([]string{
+		"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+		"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys",
+	})
+}
+
+func TestKMSKeyListWalletNotFound(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, KMSKeyListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"service":     tftypes.NewValue(tftypes.String, "service1"),
+		"wallet":      tftypes.NewValue(tftypes.String, "wallet1_id"),
+	}, false, 0)
+	assert.Len(t, results, 1)
+	assert.True(t, results[0].Diagnostics.HasError())
+	mp.checkClearCalls([]string{
+		"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+	})
+}
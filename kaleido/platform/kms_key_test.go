@@ -73,6 +73,8 @@ func TestKMSKey1(t *testing.T) {
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys/{key}",
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys/{key}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 			"DELETE /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys/{key}",
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys/{key}",
 		})
@@ -139,6 +141,15 @@ func TestKMSKey1(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      kms_key1Resource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[kms_key1Resource].Primary.Attributes["id"]
+					return fmt.Sprintf("env1/service1/wallet1_id/%s", id), nil
+				},
+			},
 		},
 	})
 }
@@ -20,24 +20,29 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type KMSWalletResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Environment        types.String `tfsdk:"environment"`
-	Service            types.String `tfsdk:"service"`
-	Type               types.String `tfsdk:"type"`
-	Name               types.String `tfsdk:"name"`
-	ConfigJSON         types.String `tfsdk:"config_json"`
-	CredsJSON          types.String `tfsdk:"creds_json"`
-	KeyDiscoveryConfig types.Map    `tfsdk:"key_discovery_config"`
+	ID                 types.String  `tfsdk:"id"`
+	Environment        types.String  `tfsdk:"environment"`
+	Service            types.String  `tfsdk:"service"`
+	Type               types.String  `tfsdk:"type"`
+	Name               types.String  `tfsdk:"name"`
+	ConfigJSON         jsonStringVal `tfsdk:"config_json"`
+	CredsJSON          jsonStringVal `tfsdk:"creds_json"`
+	CredsJSONWO        types.String  `tfsdk:"creds_json_wo"`
+	CredsJSONWOVersion types.Int64   `tfsdk:"creds_json_wo_version"`
+	KeyDiscoveryConfig types.Map     `tfsdk:"key_discovery_config"`
 }
 
 type KMSWalletAPIModel struct {
@@ -59,6 +64,9 @@ type kms_walletResource struct {
 	commonResource
 }
 
+// kmsWalletIdentity identifies a wallet by the attributes of its import ID
+var kmsWalletIdentity = resourceIdentity{"environment", "service", "id"}
+
 func (r *kms_walletResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_kms_wallet"
 }
@@ -91,15 +99,25 @@ func (r *kms_walletResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Wallet Display Name",
 			},
 			"config_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Optional JSON object containing configuration applicable to the wallet type.",
 			},
 			"creds_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
 				Description: "Optional JSON object containing credentials applicable to the wallet type.",
 			},
+			"creds_json_wo": &schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("creds_json"))},
+				Description: "Write-only alternative to `creds_json`, that is never stored in state. Requires Terraform 1.11 or later. The credentials are sent whenever the wallet is created or updated, so change `creds_json_wo_version` to update them.",
+			},
+			"creds_json_wo_version": writeOnlyVersionAttribute("creds_json_wo"),
 			"key_discovery_config": &schema.MapAttribute{
 				Optional:    true,
 				ElementType: types.ListType{ElemType: types.StringType},
@@ -133,6 +151,8 @@ func (data *KMSWalletResourceModel) toAPI(ctx context.Context, api *KMSWalletAPI
 }
 
 func (api *KMSWalletAPIModel) toData(ctx context.Context, data *KMSWalletResourceModel, diagnostics *diag.Diagnostics) {
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
 	var config string
 	if api.Configuration != nil {
 		d, err := json.Marshal(api.Configuration)
@@ -143,7 +163,7 @@ func (api *KMSWalletAPIModel) toData(ctx context.Context, data *KMSWalletResourc
 		config = `{}`
 	}
 
-	data.ConfigJSON = types.StringValue(config)
+	data.ConfigJSON = newJSONString(config)
 	var credentials string
 	if api.Credentials != nil {
 		d, err := json.Marshal(api.Credentials)
@@ -153,7 +173,7 @@ func (api *KMSWalletAPIModel) toData(ctx context.Context, data *KMSWalletResourc
 	} else {
 		credentials = `{}`
 	}
-	data.CredsJSON = types.StringValue(credentials)
+	data.CredsJSON = newJSONString(credentials)
 
 	if len(api.KeyDiscoveryConfig) > 0 {
 		keyDiscoveryConfig, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, api.KeyDiscoveryConfig)
@@ -164,6 +184,22 @@ func (api *KMSWalletAPIModel) toData(ctx context.Context, data *KMSWalletResourc
 	data.ID = types.StringValue(api.ID)
 }
 
+// credsFromConfig sets the credentials sent to the API from creds_json_wo, if configured. Write-only
+// values are only available in the config, and are null in the plan and state.
+func (r *kms_walletResource) credsFromConfig(ctx context.Context, config tfsdk.Config, api *KMSWalletAPIModel, diagnostics *diag.Diagnostics) bool {
+	var credsJSON types.String
+	diagnostics.Append(config.GetAttribute(ctx, path.Root("creds_json_wo"), &credsJSON)...)
+	if credsJSON.IsNull() || credsJSON.IsUnknown() {
+		return false
+	}
+	api.Credentials = map[string]interface{}{}
+	if err := json.Unmarshal([]byte(credsJSON.ValueString()), &api.Credentials); err != nil {
+		diagnostics.AddAttributeError(path.Root("creds_json_wo"), "invalid JSON", err.Error())
+		return false
+	}
+	return true
+}
+
 func (r *kms_walletResource) apiPath(data *KMSWalletResourceModel) string {
 	path := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/wallets", data.Environment.ValueString(), data.Service.ValueString())
 	if data.ID.ValueString() != "" {
@@ -179,12 +215,21 @@ func (r *kms_walletResource) Create(ctx context.Context, req resource.CreateRequ
 
 	var api KMSWalletAPIModel
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics)
+	writeOnlyCreds := r.credsFromConfig(ctx, req.Config, &api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("configuration", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	if !ok {
 		return
 	}
 
 	api.toData(ctx, &data, &resp.Diagnostics)
+	if writeOnlyCreds {
+		// Never store write-only credentials in state, even if they are returned by the API
+		data.CredsJSON = newJSONString(`{}`)
+	}
+	kmsWalletIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 }
@@ -208,11 +253,19 @@ func (r *kms_walletResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update from plan
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	if ok, _ := r.apiRequest(ctx, http.MethodPatch, r.apiPath(&data), api, &api, &resp.Diagnostics); !ok {
+	writeOnlyCreds := r.credsFromConfig(ctx, req.Config, &api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ok, _ := r.apiRequest(ctx, http.MethodPatch, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("configuration", path.Root("config_json")), ErrorAttributes(req.Plan.Schema)); !ok {
 		return
 	}
 
 	api.toData(ctx, &data, &resp.Diagnostics)
+	if writeOnlyCreds {
+		data.CredsJSON = newJSONString(`{}`)
+	}
+	kmsWalletIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -240,6 +293,7 @@ func (r *kms_walletResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.CredsJSON = currentCreds
 	}
 
+	kmsWalletIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -251,3 +305,11 @@ func (r *kms_walletResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *kms_walletResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = kmsWalletIdentity.schema()
+}
+
+func (r *kms_walletResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, kmsWalletIdentity...)
+}
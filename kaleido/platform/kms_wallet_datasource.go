@@ -0,0 +1,134 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KMSWalletDatasourceModel struct {
+	ID                 types.String  `tfsdk:"id"`
+	Environment        types.String  `tfsdk:"environment"`
+	Service            types.String  `tfsdk:"service"`
+	Type               types.String  `tfsdk:"type"`
+	Name               types.String  `tfsdk:"name"`
+	ConfigJSON         jsonStringVal `tfsdk:"config_json"`
+	KeyDiscoveryConfig types.Map     `tfsdk:"key_discovery_config"`
+}
+
+func (api *KMSWalletAPIModel) toDatasourceData(ctx context.Context, data *KMSWalletDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(api.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
+	config := api.Configuration
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		diagnostics.AddError("failed to marshal wallet configuration", err.Error())
+		return
+	}
+	data.ConfigJSON = newJSONString(string(b))
+	keyDiscoveryConfig := api.KeyDiscoveryConfig
+	if keyDiscoveryConfig == nil {
+		keyDiscoveryConfig = map[string][]string{}
+	}
+	var d diag.Diagnostics
+	data.KeyDiscoveryConfig, d = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, keyDiscoveryConfig)
+	diagnostics.Append(d...)
+}
+
+func KMSWalletDatasourceModelFactory() datasource.DataSource {
+	return &kms_walletDatasource{}
+}
+
+type kms_walletDatasource struct {
+	commonDataSource
+}
+
+func (r *kms_walletDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_kms_wallet"
+}
+
+func (r *kms_walletDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing wallet in a key manager by name or ID. Wallet credentials are not returned.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Key Manager Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wallet ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wallet Display Name. Exactly one of `id` or `name` must be set",
+			},
+			"type": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Wallet Type",
+			},
+			"config_json": &schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsonStringType{},
+				Description: "JSON object containing configuration applicable to the wallet type.",
+			},
+			"key_discovery_config": &schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (r *kms_walletDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *kms_walletDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KMSWalletDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api KMSWalletAPIModel
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/wallets", data.Environment.ValueString(), data.Service.ValueString())
+	if !lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "wallet", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(ctx, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
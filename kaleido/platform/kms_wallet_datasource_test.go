@@ -0,0 +1,98 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var kmsWalletDataStep1 = `
+data "kaleido_platform_kms_wallet" "wallet1" {
+    environment = "env1"
+    service = "service1"
+    name = "wallet1"
+}
+`
+
+func TestKMSWalletData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.kmsWallets["env1/service1/wallet1_id"] = &KMSWalletAPIModel{
+		ID:            "wallet1_id",
+		Name:          "wallet1",
+		Type:          "hdwallet",
+		Configuration: map[string]interface{}{"setting1": "value1"},
+		Credentials:   map[string]interface{}{"cred1": "secret"},
+		KeyDiscoveryConfig: map[string][]string{
+			"secp256k1": {"m/44'/60'/0'/0/0"},
+		},
+	}
+	// Prefix match only, so must not be returned
+	mp.kmsWallets["env1/service1/wallet2_id"] = &KMSWalletAPIModel{ID: "wallet2_id", Name: "wallet10", Type: "hdwallet"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+		})
+		mp.server.Close()
+	}()
+
+	walletData := "data.kaleido_platform_kms_wallet.wallet1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + kmsWalletDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(walletData, "id", "wallet1_id"),
+					resource.TestCheckResourceAttr(walletData, "type", "hdwallet"),
+					resource.TestCheckResourceAttr(walletData, "config_json", `{"setting1":"value1"}`),
+					resource.TestCheckResourceAttr(walletData, "key_discovery_config.secp256k1.0", "m/44'/60'/0'/0/0"),
+					resource.TestCheckNoResourceAttr(walletData, "creds_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestKMSWalletDataNotFound(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + kmsWalletDataStep1,
+				ExpectError: regexp.MustCompile(`No wallet with name 'wallet1' was found`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listKMSWallets(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.kmsWallets, mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/")
+}
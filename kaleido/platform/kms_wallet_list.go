@@ -0,0 +1,76 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KMSWalletListModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Service     types.String `tfsdk:"service"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+}
+
+func KMSWalletListResourceFactory() list.ListResource {
+	return &kms_walletResource{}
+}
+
+func (r *kms_walletResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "List the wallets in a Key Manager service, optionally filtered by name prefix.",
+		Attributes: map[string]listschema.Attribute{
+			"environment": listschema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": listschema.StringAttribute{
+				Required:    true,
+				Description: "Key Manager Service ID",
+			},
+			"name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list wallets with a name starting with this prefix",
+			},
+		},
+	}
+}
+
+func (r *kms_walletResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config KMSWalletListModel
+	diagnostics := req.Config.Get(ctx, &config)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/wallets", config.Environment.ValueString(), config.Service.ValueString())
+	wallets, ok := listAll[KMSWalletAPIModel](ctx, r, collectionPath, url.Values{}, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	wallets = filterByNamePrefix(wallets, config.NamePrefix, func(v *KMSWalletAPIModel) string { return v.Name })
+
+	stream.Results = listResults(ctx, req, kmsWalletIdentity, []types.String{config.Environment, config.Service}, wallets, func(api *KMSWalletAPIModel, data *KMSWalletResourceModel, diagnostics *diag.Diagnostics) {
+		api.toData(ctx, data, diagnostics)
+	})
+}
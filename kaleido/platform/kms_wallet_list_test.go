@@ -0,0 +1,61 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKMSWalletList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.kmsWallets["env1/service1/wallet1_id"] = &KMSWalletAPIModel{ID: "wallet1_id", Name: "hd-wallet1", Type: "hdwallet",
+		Configuration: map[string]interface{}{"keyStore": "store1"}}
+	mp.kmsWallets["env1/service1/wallet2_id"] = &KMSWalletAPIModel{ID: "wallet2_id", Name: "hd-wallet2", Type: "hdwallet"}
+	mp.kmsWallets["env1/service1/wallet3_id"] = &KMSWalletAPIModel{ID: "wallet3_id", Name: "azure-wallet", Type: "azurekeyvault"}
+	mp.kmsWallets["env1/service2/wallet4_id"] = &KMSWalletAPIModel{ID: "wallet4_id", Name: "hd-wallet1", Type: "hdwallet"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, KMSWalletListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"service":     tftypes.NewValue(tftypes.String, "service1"),
+		"name_prefix": tftypes.NewValue(tftypes.String, "hd-"),
+	}, true, 0)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "hd-wallet1", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "id": "wallet1_id"}, testListResultAttrs(t, results[0].Identity, "environment", "service", "id"))
+	assert.Equal(t, map[string]string{
+		"environment": "env1",
+		"service":     "service1",
+		"id":          "wallet1_id",
+		"name":        "hd-wallet1",
+		"type":        "hdwallet",
+		"config_json": `{"keyStore":"store1"}`,
+	}, testListResultAttrs(t, results[0].Resource, "environment", "service", "id", "name", "type", "config_json"))
+	assert.Equal(t, "hd-wallet2", results[1].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "id": "wallet2_id"}, testListResultAttrs(t, results[1].Identity, "environment", "service", "id"))
+	mp.checkClearCalls([]string{
+		"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 
 	_ "embed"
@@ -71,6 +72,7 @@ func TestKMSWallet1(t *testing.T) {
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 			"PATCH /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 			"DELETE /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
 		})
@@ -130,6 +132,15 @@ func TestKMSWallet1(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      kms_wallet1Resource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[kms_wallet1Resource].Primary.Attributes["id"]
+					return fmt.Sprintf("env1/service1/%s", id), nil
+				},
+			},
 		},
 	})
 }
@@ -332,3 +343,83 @@ func TestKMSWalletKeyDiscoveryConfig(t *testing.T) {
 		},
 	})
 }
+
+var kms_walletWriteOnlyCredsStep1 = `
+resource "kaleido_platform_kms_wallet" "kms_wallet_wo" {
+    environment = "env1"
+	service = "service1"
+    type = "azurekeyvault"
+    name = "keystore_wo"
+    creds_json_wo = jsonencode({
+        "clientSecret": "secret1"
+    })
+    creds_json_wo_version = 1
+}
+`
+
+var kms_walletWriteOnlyCredsStep2 = `
+resource "kaleido_platform_kms_wallet" "kms_wallet_wo" {
+    environment = "env1"
+	service = "service1"
+    type = "azurekeyvault"
+    name = "keystore_wo"
+    creds_json_wo = jsonencode({
+        "clientSecret": "secret2"
+    })
+    creds_json_wo_version = 2
+}
+`
+
+func TestKMSWalletWriteOnlyCreds(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /endpoint/{env}/{service}/rest/api/v1/wallets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"PATCH /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"DELETE /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}",
+		})
+		mp.server.Close()
+	}()
+
+	kms_walletResource := "kaleido_platform_kms_wallet.kms_wallet_wo"
+	checkCreds := func(secret string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			id := s.RootModule().Resources[kms_walletResource].Primary.Attributes["id"]
+			obj := mp.kmsWallets[fmt.Sprintf("env1/service1/%s", id)]
+			assert.NotNil(t, obj)
+			assert.Equal(t, map[string]interface{}{"clientSecret": secret}, obj.Credentials)
+			return nil
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + kms_walletWriteOnlyCredsStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(kms_walletResource, "id"),
+					resource.TestCheckNoResourceAttr(kms_walletResource, "creds_json_wo"),
+					resource.TestCheckResourceAttr(kms_walletResource, "creds_json", `{}`),
+					resource.TestCheckResourceAttr(kms_walletResource, "creds_json_wo_version", "1"),
+					checkCreds("secret1"),
+				),
+			},
+			{
+				Config: providerConfig + kms_walletWriteOnlyCredsStep2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(kms_walletResource, "creds_json_wo"),
+					resource.TestCheckResourceAttr(kms_walletResource, "creds_json", `{}`),
+					resource.TestCheckResourceAttr(kms_walletResource, "creds_json_wo_version", "2"),
+					checkCreds("secret2"),
+				),
+			},
+		},
+	})
+}
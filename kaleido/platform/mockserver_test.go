@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,9 +48,11 @@ type mockPlatform struct {
 	arsNamespaces               map[string]*ARSNamespaceAPIModel
 	kmsKeys                     map[string]*KMSKeyAPIModel
 	cmsBuilds                   map[string]*CMSBuildAPIModel
+	cmsBuildsNeverComplete      bool
 	cmsActions                  map[string]CMSActionAPIBaseAccessor
 	amsTasks                    map[string]*AMSTaskAPIModel
 	amsTaskVersions             map[string]map[string]interface{}
+	amsTaskVersionPosts         map[string]int
 	amsPolicies                 map[string]*AMSPolicyAPIModel
 	amsPolicyVersions           map[string]*AMSPolicyVersionAPIModel
 	amsDMUpserts                map[string]map[string]interface{}
@@ -58,8 +61,13 @@ type mockPlatform struct {
 	amsVariableSets             map[string]*AMSVariableSetAPIModel
 	amsCollections              map[string]*AMSCollectionAPIModel
 	groups                      map[string]*GroupAPIModel
+	groupMembers                map[string]*GroupMembershipAPIModel
+	users                       map[string]*UserAPIModel
 	ffsNode                     *FireFlyStatusNodeAPIModel
 	ffsOrg                      *FireFlyStatusOrgAPIModel
+	ffsNamespace                *FireFlyNamespaceAPIModel
+	ffsMultiparty               *FireFlyStatusMultipartyAPIModel
+	fireflyNamespaces           []*FireFlyNamespaceAPIModel
 	calls                       []string
 	applications                map[string]*ApplicationAPIModel
 	apiKeys                     map[string]*APIKeyAPIModel
@@ -102,6 +110,7 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 		cmsActions:                  make(map[string]CMSActionAPIBaseAccessor),
 		amsTasks:                    make(map[string]*AMSTaskAPIModel),
 		amsTaskVersions:             make(map[string]map[string]interface{}),
+		amsTaskVersionPosts:         make(map[string]int),
 		amsPolicies:                 make(map[string]*AMSPolicyAPIModel),
 		amsPolicyVersions:           make(map[string]*AMSPolicyVersionAPIModel),
 		amsDMUpserts:                make(map[string]map[string]interface{}),
@@ -110,6 +119,8 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 		amsVariableSets:             make(map[string]*AMSVariableSetAPIModel),
 		amsCollections:              make(map[string]*AMSCollectionAPIModel),
 		groups:                      make(map[string]*GroupAPIModel),
+		groupMembers:                make(map[string]*GroupMembershipAPIModel),
+		users:                       make(map[string]*UserAPIModel),
 		applications:                make(map[string]*ApplicationAPIModel),
 		serviceAccess:               make(map[string]*ServiceAccessAPIModel),
 		serviceAccessPolicies:       make(map[string]*ServiceAccessPolicyAPIModel),
@@ -144,24 +155,36 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/api/v1/environments/{env}", http.MethodPut, mp.putEnvironment)
 	mp.register("/api/v1/environments/{env}", http.MethodDelete, mp.deleteEnvironment)
 
+	// See environment_datasource_test.go
+	mp.register("/api/v1/environments", http.MethodGet, mp.listEnvironments)
+
 	// See runtime_test.go
 	mp.register("/api/v1/environments/{env}/runtimes", http.MethodPost, mp.postRuntime)
 	mp.register("/api/v1/environments/{env}/runtimes/{runtime}", http.MethodGet, mp.getRuntime)
 	mp.register("/api/v1/environments/{env}/runtimes/{runtime}", http.MethodPut, mp.putRuntime)
 	mp.register("/api/v1/environments/{env}/runtimes/{runtime}", http.MethodDelete, mp.deleteRuntime)
 
+	// See runtime_datasource_test.go
+	mp.register("/api/v1/environments/{env}/runtimes", http.MethodGet, mp.listRuntimes)
+
 	// See service_test.go
 	mp.register("/api/v1/environments/{env}/services", http.MethodPost, mp.postService)
 	mp.register("/api/v1/environments/{env}/services/{service}", http.MethodGet, mp.getService)
 	mp.register("/api/v1/environments/{env}/services/{service}", http.MethodPut, mp.putService)
 	mp.register("/api/v1/environments/{env}/services/{service}", http.MethodDelete, mp.deleteService)
 
+	// See service_datasource_test.go
+	mp.register("/api/v1/environments/{env}/services", http.MethodGet, mp.listServices)
+
 	// See network_test.go
 	mp.register("/api/v1/environments/{env}/networks", http.MethodPost, mp.postNetwork)
 	mp.register("/api/v1/environments/{env}/networks/{network}", http.MethodGet, mp.getNetwork)
 	mp.register("/api/v1/environments/{env}/networks/{network}", http.MethodPut, mp.putNetwork)
 	mp.register("/api/v1/environments/{env}/networks/{network}", http.MethodDelete, mp.deleteNetwork)
 
+	// See network_datasource_test.go
+	mp.register("/api/v1/environments/{env}/networks", http.MethodGet, mp.listNetworks)
+
 	// See network_connector_test.go
 	mp.register("/api/v1/environments/{env}/networks/{net}/connectors", http.MethodPost, mp.postConnector)
 	mp.register("/api/v1/environments/{env}/networks/{net}/connectors/{connector}", http.MethodGet, mp.getConnector)
@@ -188,10 +211,17 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys/{key}", http.MethodPatch, mp.patchKMSKey)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys/{key}", http.MethodDelete, mp.deleteKMSKey)
 
+	// See kms_wallet_datasource.go, kms_key_datasource.go and wms_wallet_datasource.go
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/wallets", http.MethodGet, mp.listWallets)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}/keys", http.MethodGet, mp.listKMSKeys)
+
 	// See cms_build.go
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/builds", http.MethodPost, mp.postCMSBuild)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/builds/{build}", http.MethodGet, mp.getCMSBuild)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/builds/{build}", http.MethodPatch, mp.patchCMSBuild)
+
+	// See cms_build_datasource.go
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/builds", http.MethodGet, mp.listCMSBuilds)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/builds/{build}", http.MethodDelete, mp.deleteCMSBuild)
 
 	// See cms_actions_base.go
@@ -206,6 +236,7 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/tasks/{task}/versions", http.MethodPost, mp.postAMSTaskVersion)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/tasks/{task}", http.MethodPatch, mp.patchAMSTask)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/tasks/{task}", http.MethodDelete, mp.deleteAMSTask)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/tasks", http.MethodGet, mp.listAMSTasks)
 
 	// See ams_policy.go
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/policies/{policy}", http.MethodGet, mp.getAMSPolicy)
@@ -242,6 +273,10 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/network/organizations/self", http.MethodPost, mp.postFireFlyRegistrationOrg)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/status", http.MethodGet, mp.getFireFlyStatus)
 
+	// See firefly_status_datasource.go
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/namespaces", http.MethodGet, mp.listFireFlyNamespaces)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/namespaces/{ns}/status", http.MethodGet, mp.getFireFlyStatus)
+
 	// See wms_wallet.go (PUT only; other methods use shared handlers above)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/wallets/{wallet}", http.MethodPut, mp.putWMSWallet)
 
@@ -250,6 +285,7 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/assets/{asset}", http.MethodPut, mp.putWMSAsset)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/assets/{asset}", http.MethodDelete, mp.deleteWMSAsset)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/assets", http.MethodPost, mp.postWMSAsset)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/assets", http.MethodGet, mp.listWMSAssets)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/assets/{asset}", http.MethodPatch, mp.patchWMSAsset)
 
 	// See wms_asset_icon.go
@@ -259,11 +295,13 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 
 	// See wms_account.go
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/assets/{asset}/connect/{wallet}", http.MethodPost, mp.connectWMSAccount)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/accounts", http.MethodGet, mp.listWMSAccounts)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/accounts/{account}", http.MethodGet, mp.getWMSAccount)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/accounts/{account}", http.MethodDelete, mp.deleteWMSAccount)
 
 	// See policy_identity.go
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/identities", http.MethodPost, mp.postPolicyIdentity)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/identities", http.MethodGet, mp.listPolicyIdentities)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/identities/{identity}", http.MethodGet, mp.getPolicyIdentity)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/identities/{identity}", http.MethodPut, mp.putPolicyIdentity)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/identities/{identity}", http.MethodDelete, mp.deletePolicyIdentity)
@@ -303,17 +341,30 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/api/v1/environments/{env}/stacks/{stack}", http.MethodGet, mp.getStacks)
 	mp.register("/api/v1/environments/{env}/stacks/{stack}", http.MethodPut, mp.putStacks)
 	mp.register("/api/v1/environments/{env}/stacks/{stack}", http.MethodDelete, mp.deleteStacks)
+
+	// See stacks_datasource_test.go
+	mp.register("/api/v1/environments/{env}/stacks", http.MethodGet, mp.listStacks)
 	// See application_test.go
 	mp.register("/api/v1/applications", http.MethodPost, mp.postApplication)
 	mp.register("/api/v1/applications/{application}", http.MethodGet, mp.getApplication)
 	mp.register("/api/v1/applications/{application}", http.MethodPatch, mp.patchApplication)
 	mp.register("/api/v1/applications/{application}", http.MethodDelete, mp.deleteApplication)
 
+	// See user_datasource.go, group_datasource.go and application_datasource.go
+	mp.register("/api/v1/users", http.MethodGet, mp.listUsers)
+	mp.register("/api/v1/users/{user}", http.MethodGet, mp.getUser)
+	mp.register("/api/v1/groups", http.MethodGet, mp.listGroups)
+	mp.register("/api/v1/groups/{group}/members", http.MethodGet, mp.listGroupMembers)
+	mp.register("/api/v1/applications", http.MethodGet, mp.listApplications)
+
 	// See apikey_test.go
 	mp.register("/api/v1/applications/{application}/api-keys", http.MethodPost, mp.postApiKey)
 	mp.register("/api/v1/applications/{application}/api-keys/{api-key}", http.MethodGet, mp.getApiKey)
 	mp.register("/api/v1/applications/{application}/api-keys/{api-key}", http.MethodDelete, mp.deleteApiKey)
 
+	// See access_token_ephemeral_test.go
+	mp.register("/oauth/token", http.MethodPost, mp.postOAuth2Token)
+
 	// See service_access_test.go
 	mp.register("/api/v1/service-access/{service}/permissions", http.MethodPost, mp.postServiceAccessPermission)
 	mp.register("/api/v1/service-access/{service}/permissions/{permission}", http.MethodGet, mp.getServiceAccessPermission)
@@ -336,6 +387,7 @@ func startMockPlatformServer(t *testing.T) *mockPlatform {
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/workflows/{workflow}", "DELETE", mp.deleteWFEWorkflow)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/workflows/{workflow}/versions", "POST", mp.postWFEWorkflowVersion)
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/workflows/{workflow}/versions/{version}", "GET", mp.getWFEWorkflowVersion)
+	mp.register("/endpoint/{env}/{service}/rest/api/v1/workflows", "GET", mp.listWFEWorkflows)
 
 	// See wfe_stream_test.go
 	mp.register("/endpoint/{env}/{service}/rest/api/v1/streams/{stream}", "PUT", mp.putWFEStream)
@@ -399,6 +451,17 @@ func (mp *mockPlatform) getWallet(res http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (mp *mockPlatform) listWallets(res http.ResponseWriter, req *http.Request) {
+	prefix := mux.Vars(req)["env"] + "/" + mux.Vars(req)["service"] + "/"
+	for key := range mp.wmsWallets {
+		if strings.HasPrefix(key, prefix) {
+			respondList(mp, res, req, mp.wmsWallets, prefix)
+			return
+		}
+	}
+	mp.listKMSWallets(res, req)
+}
+
 func (mp *mockPlatform) patchWallet(res http.ResponseWriter, req *http.Request) {
 	if mp.isWMSWalletKey(mp.walletKey(req)) {
 		mp.patchWMSWallet(res, req)
@@ -462,6 +525,49 @@ func (mp *mockPlatform) respond(res http.ResponseWriter, body interface{}, statu
 	}
 }
 
+// respondList responds with a page of the objects stored under the key prefix in key order. Like the
+// platform, each query parameter (including name) matches a field exactly.
+func respondList[T any](mp *mockPlatform, res http.ResponseWriter, req *http.Request, objects map[string]*T, keyPrefix string) {
+	keys := make([]string, 0, len(objects))
+	for k := range objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	query := req.URL.Query()
+	list := PlatformListAPIModel[*T]{Items: []*T{}}
+	var matched []*T
+	for _, k := range keys {
+		if !strings.HasPrefix(k, keyPrefix) {
+			continue
+		}
+		var fields map[string]interface{}
+		b, err := json.Marshal(objects[k])
+		assert.NoError(mp.t, err)
+		assert.NoError(mp.t, json.Unmarshal(b, &fields))
+		match := true
+		for param, values := range query {
+			if param == "limit" || param == "skip" {
+				continue
+			}
+			value, _ := fields[param].(string)
+			match = match && value == values[0]
+		}
+		if match {
+			matched = append(matched, objects[k])
+		}
+	}
+	skip, _ := strconv.Atoi(query.Get("skip"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = len(matched)
+	}
+	for i := skip; i < len(matched) && i < skip+limit; i++ {
+		list.Items = append(list.Items, matched[i])
+	}
+	list.Count = len(matched)
+	mp.respond(res, &list, 200)
+}
+
 func (mp *mockPlatform) getBody(req *http.Request, body interface{}) {
 	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-yaml") {
 		err := yaml.NewDecoder(req.Body).Decode(body)
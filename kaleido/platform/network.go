@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,20 +32,23 @@ import (
 )
 
 type NetworkResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Environment         types.String `tfsdk:"environment"`
-	Type                types.String `tfsdk:"type"`
-	Name                types.String `tfsdk:"name"`
-	ConfigJSON          types.String `tfsdk:"config_json"`
-	Info                types.Map    `tfsdk:"info"`
-	EnvironmentMemberID types.String `tfsdk:"environment_member_id"`
-	InitFiles           types.String `tfsdk:"init_files"`
-	InitMode            types.String `tfsdk:"init_mode"`
-	Initialized         types.Bool   `tfsdk:"initialized"`
-	Filesets            types.Map    `tfsdk:"file_sets"`
-	Credsets            types.Map    `tfsdk:"cred_sets"`
-	StatusInitFiles     types.Map    `tfsdk:"status_init_files"`
-	ForceDelete         types.Bool   `tfsdk:"force_delete"`
+	ID                  types.String   `tfsdk:"id"`
+	Environment         types.String   `tfsdk:"environment"`
+	Type                types.String   `tfsdk:"type"`
+	Name                types.String   `tfsdk:"name"`
+	ConfigJSON          jsonStringVal  `tfsdk:"config_json"`
+	Info                types.Map      `tfsdk:"info"`
+	EnvironmentMemberID types.String   `tfsdk:"environment_member_id"`
+	InitFiles           types.String   `tfsdk:"init_files"`
+	InitMode            types.String   `tfsdk:"init_mode"`
+	Initialized         types.Bool     `tfsdk:"initialized"`
+	Filesets            types.Map      `tfsdk:"file_sets"`
+	Credsets            types.Map      `tfsdk:"cred_sets"`
+	CredsetsWO          types.Map      `tfsdk:"cred_sets_wo"`
+	CredsetsWOVersion   types.Int64    `tfsdk:"cred_sets_wo_version"`
+	StatusInitFiles     types.Map      `tfsdk:"status_init_files"`
+	ForceDelete         types.Bool     `tfsdk:"force_delete"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type NetworkAPIModel struct {
@@ -75,13 +79,19 @@ type networkResource struct {
 	commonResource
 }
 
+// networkIdentity identifies a network by the attributes of its import ID
+var networkIdentity = resourceIdentity{"environment", "id"}
+
 func (r *networkResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_network"
 }
 
-func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *networkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Networks provide an anchor object for multiple services that need to communicate together, and allow services to discover other services they need communicate with.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -105,7 +115,8 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed: true,
 			},
 			"config_json": &schema.StringAttribute{
-				Required: true,
+				Required:   true,
+				CustomType: jsonStringType{stripNulls: true},
 			},
 			"info": &schema.MapAttribute{
 				Description: "Top-level config captured from the network after creation, including generated values like the chain id",
@@ -188,6 +199,8 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
+			"cred_sets_wo":         credSetsWriteOnlyAttribute(),
+			"cred_sets_wo_version": writeOnlyVersionAttribute("cred_sets_wo"),
 			"status_init_files": &schema.MapAttribute{
 				Computed:    true,
 				ElementType: types.StringType,
@@ -277,50 +290,15 @@ func (data *NetworkResourceModel) toAPI(ctx context.Context, api *NetworkAPIMode
 
 	// credsets (complex nested structure)
 	if !data.Credsets.IsNull() {
-		basicAuthAttrs := map[string]attr.Type{
-			"username": types.StringType,
-			"password": types.StringType,
-		}
-		keyAttrs := map[string]attr.Type{
-			"value": types.StringType,
-		}
-		api.Credsets = make(map[string]*CredSetAPI)
-		tfCredsets := data.Credsets.Elements()
-		for credSetName, tfCredSetAttr := range tfCredsets {
-			tfCredSet := tfCredSetAttr.(types.Object)
-			tfCredSetAttrs := tfCredSet.Attributes()
-			crType := tfCredSetAttrs["type"].(types.String).ValueString()
-			cr := &CredSetAPI{
-				Name: credSetName,
-				Type: crType,
-			}
-			if crType == "basic_auth" && !tfCredSetAttrs["basic_auth"].IsNull() {
-				tfBasicAuth, d := types.ObjectValueFrom(ctx, basicAuthAttrs, tfCredSetAttrs["basic_auth"])
-				diagnostics.Append(d...)
-				tfBasicAuthAttrs := tfBasicAuth.Attributes()
-				cr.BasicAuth = &CredSetBasicAuthAPI{
-					Username: tfBasicAuthAttrs["username"].(types.String).ValueString(),
-					Password: tfBasicAuthAttrs["password"].(types.String).ValueString(),
-				}
-			} else if crType == "key" && !tfCredSetAttrs["key"].IsNull() {
-				tfKey, d := types.ObjectValueFrom(ctx, keyAttrs, tfCredSetAttrs["key"])
-				diagnostics.Append(d...)
-				tfKeyAttrs := tfKey.Attributes()
-				cr.Key = &CredSetKeyAPI{
-					Value: tfKeyAttrs["value"].(types.String).ValueString(),
-				}
-			} else {
-				diagnostics.AddError("missing credential", fmt.Sprintf("must specify key/basic_auth as appropriate for type '%s'", crType))
-				return
-			}
-			api.Credsets[credSetName] = cr
-		}
+		api.Credsets = credSetsToAPI(ctx, data.Credsets, diagnostics)
 	}
 
 }
 
 func (api *NetworkAPIModel) toData(data *NetworkResourceModel, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(api.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
 	data.Initialized = types.BoolValue(api.Initialized)
 	data.EnvironmentMemberID = types.StringValue(api.EnvironmentMemberID)
 	info := make(map[string]attr.Value)
@@ -375,10 +353,18 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var data NetworkResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api NetworkAPIModel
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics)
+	credSetsFromConfig(ctx, req.Config, &api.Credsets, &resp.Diagnostics)
+	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	if !ok {
 		return
 	}
@@ -386,6 +372,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	api.toData(&data, &resp.Diagnostics) // need the ID copied over
 	r.waitForReadyStatus(ctx, r.apiPath(&data), &resp.Diagnostics)
 	api.toData(&data, &resp.Diagnostics) // need the latest status after the readiness check completes, to extract generated values
+	networkIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 }
@@ -395,6 +382,13 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 	var data NetworkResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Read full current object
 	var api NetworkAPIModel
@@ -404,19 +398,28 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Update from plan
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	if ok, _ := r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), api, &api, &resp.Diagnostics); !ok {
+	credSetsFromConfig(ctx, req.Config, &api.Credsets, &resp.Diagnostics)
+	if ok, _ := r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema)); !ok {
 		return
 	}
 
 	api.toData(&data, &resp.Diagnostics) // need the ID copied over
 	r.waitForReadyStatus(ctx, r.apiPath(&data), &resp.Diagnostics)
 	api.toData(&data, &resp.Diagnostics) // need the latest status after the readiness check completes, to extract generated values
+	networkIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data NetworkResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api NetworkAPIModel
 	api.ID = data.ID.ValueString()
@@ -430,14 +433,31 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	api.toData(&data, &resp.Diagnostics)
+	data.ConfigJSON = configJSONFromAPI(data.ConfigJSON, api.Config, &resp.Diagnostics)
+	networkIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data NetworkResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data), nil, nil, &resp.Diagnostics, Allow404())
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *networkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = networkIdentity.schema()
+}
+
+func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, networkIdentity...)
+}
@@ -40,7 +40,7 @@ type ConnectorResourceModel struct {
 	Environment       types.String                     `tfsdk:"environment"`
 	Network           types.String                     `tfsdk:"network"`
 	Zone              types.String                     `tfsdk:"zone"`
-	PermittedJSON     types.String                     `tfsdk:"permitted_json"`
+	PermittedJSON     jsonStringVal                    `tfsdk:"permitted_json"`
 	PlatformRequestor *RequestorPlatformConnectorModel `tfsdk:"platform_requestor"`
 	PlatformAcceptor  *AcceptorPlatformConnectorModel  `tfsdk:"platform_acceptor"`
 }
@@ -112,7 +112,8 @@ func (r *connectorResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"permitted_json": &schema.StringAttribute{
-				Optional: true,
+				CustomType: jsonStringType{},
+				Optional:   true,
 			},
 			"platform_requestor": &schema.SingleNestedAttribute{
 				Optional:      true,
@@ -310,3 +311,7 @@ func (r *connectorResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *connectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "network", "id")
+}
@@ -0,0 +1,138 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworkDatasourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Environment         types.String  `tfsdk:"environment"`
+	Type                types.String  `tfsdk:"type"`
+	Name                types.String  `tfsdk:"name"`
+	ConfigJSON          jsonStringVal `tfsdk:"config_json"`
+	EnvironmentMemberID types.String  `tfsdk:"environment_member_id"`
+	Status              types.String  `tfsdk:"status"`
+	Initialized         types.Bool    `tfsdk:"initialized"`
+	StatusDetailsJSON   jsonStringVal `tfsdk:"status_details_json"`
+}
+
+func (api *NetworkAPIModel) toDatasourceData(data *NetworkDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(api.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
+	data.ConfigJSON = configJSONFromAPI(jsonStringNull(), api.Config, diagnostics)
+	data.EnvironmentMemberID = types.StringValue(api.EnvironmentMemberID)
+	data.Status = types.StringValue(api.Status)
+	data.Initialized = types.BoolValue(api.Initialized)
+	statusDetails := api.StatusDetails
+	if statusDetails == nil {
+		statusDetails = NetworkStatusDetails{}
+	}
+	b, err := json.Marshal(statusDetails)
+	if err != nil {
+		diagnostics.AddError("failed to marshal status details", err.Error())
+		return
+	}
+	data.StatusDetailsJSON = newJSONString(string(b))
+}
+
+func NetworkDatasourceModelFactory() datasource.DataSource {
+	return &networkDatasource{}
+}
+
+type networkDatasource struct {
+	commonDataSource
+}
+
+func (r *networkDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_network"
+}
+
+func (r *networkDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing network in an environment by name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Network ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Network Display Name. Exactly one of `id` or `name` must be set",
+			},
+			"type": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Network Type",
+			},
+			"config_json": &schema.StringAttribute{
+				Computed:   true,
+				CustomType: jsonStringType{stripNulls: true},
+			},
+			"environment_member_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"status": &schema.StringAttribute{
+				Computed: true,
+			},
+			"initialized": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"status_details_json": &schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsonStringType{},
+				Description: "Status details reported by the network, such as the generated genesis information and init files",
+			},
+		},
+	}
+}
+
+func (r *networkDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *networkDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworkDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api NetworkAPIModel
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/networks", data.Environment.ValueString())
+	if !lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "network", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(&data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
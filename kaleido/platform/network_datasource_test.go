@@ -0,0 +1,98 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var networkDataStep1 = `
+data "kaleido_platform_network" "network1" {
+    environment = "env1"
+    name = "network1"
+}
+`
+
+func TestNetworkDataAmbiguousName(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.networks["env1/net1"] = &NetworkAPIModel{ID: "net1", Name: "network1", Type: "Besu"}
+	mp.networks["env1/net2"] = &NetworkAPIModel{ID: "net2", Name: "network1", Type: "Besu"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/networks",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + networkDataStep1,
+				ExpectError: regexp.MustCompile(`2 objects with name 'network1' were found`),
+			},
+		},
+	})
+}
+
+func TestNetworkData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.networks["env1/net1"] = &NetworkAPIModel{
+		ID:          "net1",
+		Name:        "network1",
+		Type:        "Besu",
+		Status:      "ready",
+		Initialized: true,
+		Config:      map[string]interface{}{"chainID": float64(12345)},
+		StatusDetails: NetworkStatusDetails{
+			"initFiles": map[string]interface{}{"genesis.json": "{}"},
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/networks",
+			"GET /api/v1/environments/{env}/networks",
+			"GET /api/v1/environments/{env}/networks",
+		})
+		mp.server.Close()
+	}()
+
+	networkData := "data.kaleido_platform_network.network1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + networkDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(networkData, "id", "net1"),
+					resource.TestCheckResourceAttr(networkData, "status", "ready"),
+					resource.TestCheckResourceAttr(networkData, "initialized", "true"),
+					resource.TestCheckResourceAttr(networkData, "config_json", `{"chainID":12345}`),
+					resource.TestCheckResourceAttr(networkData, "status_details_json", `{"initFiles":{"genesis.json":"{}"}}`),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listNetworks(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.networks, mux.Vars(req)["env"]+"/")
+}
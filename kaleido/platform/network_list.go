@@ -0,0 +1,85 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworkListModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Type        types.String `tfsdk:"type"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func NetworkListResourceFactory() list.ListResource {
+	return &networkResource{}
+}
+
+func (r *networkResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "List the networks in an environment, optionally filtered by type, name prefix or status.",
+		Attributes: map[string]listschema.Attribute{
+			"environment": listschema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"type": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks of this type",
+			},
+			"name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks with a name starting with this prefix",
+			},
+			"status": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks with this status, such as `ready`",
+			},
+		},
+	}
+}
+
+func (r *networkResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config NetworkListModel
+	diagnostics := req.Config.Get(ctx, &config)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	filters := url.Values{}
+	addListFilter(filters, "type", config.Type)
+	addListFilter(filters, "status", config.Status)
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/networks", config.Environment.ValueString())
+	networks, ok := listAll[NetworkAPIModel](ctx, r, collectionPath, filters, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	networks = filterByNamePrefix(networks, config.NamePrefix, func(v *NetworkAPIModel) string { return v.Name })
+
+	stream.Results = listResults(ctx, req, networkIdentity, []types.String{config.Environment}, networks, func(api *NetworkAPIModel, data *NetworkResourceModel, diagnostics *diag.Diagnostics) {
+		api.toData(data, diagnostics)
+		data.ConfigJSON = configJSONFromAPI(data.ConfigJSON, api.Config, diagnostics)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.networks["env1/net1"] = &NetworkAPIModel{ID: "net1", Name: "besu-net", Type: "Besu", Status: "ready", EnvironmentMemberID: "member1",
+		Config: map[string]interface{}{"chainID": "12345"}}
+	mp.networks["env1/net2"] = &NetworkAPIModel{ID: "net2", Name: "ipfs-net", Type: "IPFS", Status: "ready"}
+	mp.networks["env2/net3"] = &NetworkAPIModel{ID: "net3", Name: "besu-net", Type: "Besu", Status: "ready"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, NetworkListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"type":        tftypes.NewValue(tftypes.String, "Besu"),
+	}, true, 0)
+	assert.Len(t, results, 1)
+	assert.False(t, results[0].Diagnostics.HasError(), results[0].Diagnostics)
+	assert.Equal(t, "besu-net", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "net1"}, testListResultAttrs(t, results[0].Identity, "environment", "id"))
+	assert.Equal(t, map[string]string{
+		"environment":           "env1",
+		"id":                    "net1",
+		"name":                  "besu-net",
+		"type":                  "Besu",
+		"environment_member_id": "member1",
+		"config_json":           `{"chainID":"12345"}`,
+	}, testListResultAttrs(t, results[0].Resource, "environment", "id", "name", "type", "environment_member_id", "config_json"))
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/networks",
+	})
+}
@@ -66,6 +66,7 @@ func TestNetwork1(t *testing.T) {
 			"GET /api/v1/environments/{env}/networks/{network}",
 			"GET /api/v1/environments/{env}/networks/{network}",
 			"GET /api/v1/environments/{env}/networks/{network}",
+			"GET /api/v1/environments/{env}/networks/{network}",
 			"DELETE /api/v1/environments/{env}/networks/{network}",
 			"GET /api/v1/environments/{env}/networks/{network}",
 		})
@@ -124,6 +125,15 @@ func TestNetwork1(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      network1Resource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[network1Resource].Primary.Attributes["id"]
+					return fmt.Sprintf("env1/%s", id), nil
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,144 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworksDatasourceModel struct {
+	Environment types.String                  `tfsdk:"environment"`
+	Type        types.String                  `tfsdk:"type"`
+	NamePrefix  types.String                  `tfsdk:"name_prefix"`
+	Status      types.String                  `tfsdk:"status"`
+	Networks    []NetworksDatasourceItemModel `tfsdk:"networks"`
+}
+
+type NetworksDatasourceItemModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	Type                types.String  `tfsdk:"type"`
+	EnvironmentMemberID types.String  `tfsdk:"environment_member_id"`
+	Status              types.String  `tfsdk:"status"`
+	Initialized         types.Bool    `tfsdk:"initialized"`
+	ConfigJSON          jsonStringVal `tfsdk:"config_json"`
+}
+
+func (api *NetworkAPIModel) toListItemData(diagnostics *diag.Diagnostics) NetworksDatasourceItemModel {
+	return NetworksDatasourceItemModel{
+		ID:                  types.StringValue(api.ID),
+		Name:                types.StringValue(api.Name),
+		Type:                types.StringValue(api.Type),
+		EnvironmentMemberID: types.StringValue(api.EnvironmentMemberID),
+		Status:              types.StringValue(api.Status),
+		Initialized:         types.BoolValue(api.Initialized),
+		ConfigJSON:          configJSONFromAPI(jsonStringNull(), api.Config, diagnostics),
+	}
+}
+
+func NetworksDatasourceModelFactory() datasource.DataSource {
+	return &networksDatasource{}
+}
+
+type networksDatasource struct {
+	commonDataSource
+}
+
+func (r *networksDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_networks"
+}
+
+func (r *networksDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the networks in an environment, optionally filtered by type, name prefix or status.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"type": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks of this type",
+			},
+			"name_prefix": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks with a name starting with this prefix",
+			},
+			"status": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks with this status, such as `ready`",
+			},
+			"networks": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"environment_member_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"status": &schema.StringAttribute{
+							Computed: true,
+						},
+						"initialized": &schema.BoolAttribute{
+							Computed: true,
+						},
+						"config_json": &schema.StringAttribute{
+							Computed:   true,
+							CustomType: jsonStringType{stripNulls: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *networksDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworksDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := url.Values{}
+	addListFilter(filters, "type", data.Type)
+	addListFilter(filters, "status", data.Status)
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/networks", data.Environment.ValueString())
+	networks, ok := listAll[NetworkAPIModel](ctx, &r.commonDataSource, collectionPath, filters, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	networks = filterByNamePrefix(networks, data.NamePrefix, func(v *NetworkAPIModel) string { return v.Name })
+
+	data.Networks = make([]NetworksDatasourceItemModel, len(networks))
+	for i := range networks {
+		data.Networks[i] = networks[i].toListItemData(&resp.Diagnostics)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,57 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var networksDataStep1 = `
+data "kaleido_platform_networks" "networks" {
+    environment = "env1"
+    type = "PaladinNetwork"
+}
+`
+
+func TestNetworksData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.networks["env1/net1"] = &NetworkAPIModel{ID: "net1", Name: "besu", Type: "Besu", Status: "ready"}
+	mp.networks["env1/net2"] = &NetworkAPIModel{ID: "net2", Name: "paladin", Type: "PaladinNetwork", Status: "ready", Initialized: true}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/networks",
+			"GET /api/v1/environments/{env}/networks",
+			"GET /api/v1/environments/{env}/networks",
+		})
+		mp.server.Close()
+	}()
+
+	networksData := "data.kaleido_platform_networks.networks"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + networksDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(networksData, "networks.#", "1"),
+					resource.TestCheckResourceAttr(networksData, "networks.0.id", "net2"),
+					resource.TestCheckResourceAttr(networksData, "networks.0.initialized", "true"),
+				),
+			},
+		},
+	})
+}
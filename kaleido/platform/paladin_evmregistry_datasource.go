@@ -22,8 +22,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
 )
 
 type PaladinEVMRegistryDatasourceModel struct {
@@ -114,7 +112,7 @@ func (s *paladinEVMRegistryDatasource) Read(ctx context.Context, req datasource.
 	cancelInfo := APICancelInfo()
 	cancelInfo.CancelInfo = "(waiting for paladin evm registry)"
 	removed := false
-	_ = kaleidobase.Retry.Do(ctx, fmt.Sprintf("paladin-evm-registry %s", apiPath), func(attempt int) (retry bool, err error) {
+	_ = s.retry().Do(ctx, fmt.Sprintf("paladin-evm-registry %s", apiPath), func(attempt int) (retry bool, err error) {
 		api := &NetworkAPIModel{}
 		ok, status := s.apiRequest(ctx, http.MethodGet, apiPath, nil, api, &resp.Diagnostics, Allow404(), cancelInfo)
 		if !ok {
@@ -0,0 +1,95 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var kmsURIAttrTypes = map[string]attr.Type{
+	"wallet_type": types.StringType,
+	"wallet":      types.StringType,
+	"path":        types.StringType,
+}
+
+// parseKMSURI splits a key URI from the key manager, such as `hdwallet://wallet1/m/44'/60'/0'/0/0`,
+// into the wallet type, the wallet name and the path of the key in the wallet
+func parseKMSURI(uri string) (walletType, wallet, keyPath string, err error) {
+	walletType, rest, ok := strings.Cut(uri, "://")
+	if !ok || walletType == "" {
+		return "", "", "", fmt.Errorf("key URI '%s' must be of the form '<wallet_type>://<wallet>/<path>'", uri)
+	}
+	wallet, keyPath, _ = strings.Cut(rest, "/")
+	if wallet == "" || keyPath == "" {
+		return "", "", "", fmt.Errorf("key URI '%s' must be of the form '<wallet_type>://<wallet>/<path>'", uri)
+	}
+	return walletType, wallet, keyPath, nil
+}
+
+func ParseKMSURIFunctionFactory() function.Function {
+	return &parse_kms_uriFunction{}
+}
+
+type parse_kms_uriFunction struct{}
+
+func (f *parse_kms_uriFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_kms_uri"
+}
+
+func (f *parse_kms_uriFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a key manager key URI",
+		Description: "Splits the `uri` of a `kaleido_platform_kms_key`, such as `hdwallet://wallet1/m/44'/60'/0'/0/0`, into an object with the `wallet_type`, the `wallet` name, and the `path` of the key in the wallet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uri",
+				Description: "Key URI",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: kmsURIAttrTypes,
+		},
+	}
+}
+
+func (f *parse_kms_uriFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri string
+	resp.Error = req.Arguments.Get(ctx, &uri)
+	if resp.Error != nil {
+		return
+	}
+
+	walletType, wallet, keyPath, err := parseKMSURI(uri)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(kmsURIAttrTypes, map[string]attr.Value{
+		"wallet_type": types.StringValue(walletType),
+		"wallet":      types.StringValue(wallet),
+		"path":        types.StringValue(keyPath),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
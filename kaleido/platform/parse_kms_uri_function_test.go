@@ -0,0 +1,40 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKMSURIFunction(t *testing.T) {
+	result, funcErr := testRunFunction(t, ParseKMSURIFunctionFactory(), "hdwallet://wallet1/m/44'/60'/0'/0/0")
+	assert.Nil(t, funcErr)
+	assert.Equal(t, types.ObjectValueMust(kmsURIAttrTypes, map[string]attr.Value{
+		"wallet_type": types.StringValue("hdwallet"),
+		"wallet":      types.StringValue("wallet1"),
+		"path":        types.StringValue("m/44'/60'/0'/0/0"),
+	}), result)
+}
+
+func TestParseKMSURIFunctionInvalid(t *testing.T) {
+	for _, uri := range []string{"", "wallet1/key1", "hdwallet://wallet1", "hdwallet:///key1", "://wallet1/key1"} {
+		_, funcErr := testRunFunction(t, ParseKMSURIFunctionFactory(), uri)
+		assert.NotNil(t, funcErr, uri)
+		assert.Regexp(t, "must be of the form", funcErr.Text)
+	}
+}
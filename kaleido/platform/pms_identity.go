@@ -267,6 +267,10 @@ func (r *policyIdentityResource) Delete(ctx context.Context, req resource.Delete
 	_, _ = r.apiRequest(ctx, "DELETE", r.apiPath(&data), nil, nil, &resp.Diagnostics)
 }
 
+func (r *policyIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
+
 func (r *policyIdentityResource) toAPI(data *PolicyIdentityResourceModel, api *PolicyIdentityAPIModel) {
 	api.Name = data.Name.ValueString()
 	api.Description = data.Description.ValueString()
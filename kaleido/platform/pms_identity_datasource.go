@@ -0,0 +1,183 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PMSIdentityDatasourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Environment              types.String `tfsdk:"environment"`
+	Service                  types.String `tfsdk:"service"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	Owner                    types.String `tfsdk:"owner"`
+	PreferredAssertionMethod types.String `tfsdk:"preferred_assertion_method"`
+	AssertionMethod          types.List   `tfsdk:"assertion_method"`
+	NotificationMethod       types.List   `tfsdk:"notification_method"`
+}
+
+func (api *PolicyIdentityAPIModel) toDatasourceData(data *PMSIdentityDatasourceModel) {
+	var res PolicyIdentityResourceModel
+	(&policyIdentityResource{}).toData(api, &res)
+	data.ID = res.ID
+	data.Name = res.Name
+	data.Description = res.Description
+	data.Owner = res.Owner
+	data.PreferredAssertionMethod = res.PreferredAssertionMethod
+	data.AssertionMethod = res.AssertionMethod
+	data.NotificationMethod = res.NotificationMethod
+}
+
+func PMSIdentityDatasourceModelFactory() datasource.DataSource {
+	return &pms_identityDatasource{}
+}
+
+type pms_identityDatasource struct {
+	commonDataSource
+}
+
+func (r *pms_identityDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_pms_identity"
+}
+
+func (r *pms_identityDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing identity in a policy manager by name or ID, with its assertion methods and verification material.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Policy Manager Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identity ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identity Name. Exactly one of `id` or `name` must be set",
+			},
+			"description": &schema.StringAttribute{
+				Computed: true,
+			},
+			"owner": &schema.StringAttribute{
+				Computed: true,
+			},
+			"preferred_assertion_method": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the assertion method used by default to sign on behalf of the identity",
+			},
+			"assertion_method": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"identity_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"signing_method": &schema.StringAttribute{
+							Computed: true,
+						},
+						"verification_material": &schema.StringAttribute{
+							Computed:    true,
+							Description: "Material used to verify assertions made with this method, such as a public key or address",
+						},
+						"created": &schema.StringAttribute{
+							Computed: true,
+						},
+						"expires": &schema.StringAttribute{
+							Computed: true,
+						},
+						"revoked": &schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"notification_method": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"value": &schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pms_identityDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *pms_identityDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PMSIdentityDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/identities", data.Environment.ValueString(), data.Service.ValueString())
+	id := data.ID.ValueString()
+	if id == "" {
+		var match PolicyIdentityAPIModel
+		if !lookupByField(ctx, &r.commonDataSource, collectionPath, "identity", "name", "name", data.Name.ValueString(), &match, &resp.Diagnostics) {
+			return
+		}
+		id = match.ID
+	}
+
+	// The assertion methods are only returned when reading a single identity with details
+	var api PolicyIdentityAPIModel
+	if ok, _ := r.apiRequest(ctx, http.MethodGet, collectionPath+"/"+url.PathEscape(id)+"?fetchDetails=true", nil, &api, &resp.Diagnostics); !ok {
+		return
+	}
+
+	api.toDatasourceData(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,125 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var pmsIdentityDataStep1 = `
+data "kaleido_platform_pms_identity" "identity1" {
+    environment = "env1"
+    service = "service1"
+    name = "signer1"
+}
+`
+
+var pmsIdentityDataStep2 = `
+data "kaleido_platform_pms_identity" "identity1" {
+    environment = "env1"
+    service = "service1"
+    name = "unknown"
+}
+`
+
+func TestPMSIdentityData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mp.policyIdentities["identity1"] = &PolicyIdentityAPIModel{
+		ID:                       "identity1",
+		Name:                     "signer1",
+		Owner:                    "team-a",
+		PreferredAssertionMethod: "key1",
+		AssertionMethod: []AssertionMethod{
+			{
+				ID:                   "am1",
+				IdentityID:           "identity1",
+				Name:                 "key1",
+				Type:                 "publicKey",
+				SigningMethod:        "secp256k1",
+				VerificationMaterial: "0x0123456789abcdef",
+				Created:              &created,
+			},
+		},
+		NotificationMethod: []NotificationMethod{
+			{Name: "email", Type: "email", Value: "team-a@example.com"},
+		},
+	}
+	mp.policyIdentities["identity2"] = &PolicyIdentityAPIModel{ID: "identity2", Name: "signer10"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities",
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities/{identity}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities",
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities/{identity}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities",
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities/{identity}",
+		})
+		mp.server.Close()
+	}()
+
+	identityData := "data.kaleido_platform_pms_identity.identity1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + pmsIdentityDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(identityData, "id", "identity1"),
+					resource.TestCheckResourceAttr(identityData, "owner", "team-a"),
+					resource.TestCheckResourceAttr(identityData, "preferred_assertion_method", "key1"),
+					resource.TestCheckResourceAttr(identityData, "assertion_method.#", "1"),
+					resource.TestCheckResourceAttr(identityData, "assertion_method.0.name", "key1"),
+					resource.TestCheckResourceAttr(identityData, "assertion_method.0.signing_method", "secp256k1"),
+					resource.TestCheckResourceAttr(identityData, "assertion_method.0.verification_material", "0x0123456789abcdef"),
+					resource.TestCheckResourceAttr(identityData, "assertion_method.0.created", "2026-01-02T03:04:05Z"),
+					resource.TestCheckNoResourceAttr(identityData, "assertion_method.0.revoked"),
+					resource.TestCheckResourceAttr(identityData, "notification_method.#", "1"),
+					resource.TestCheckResourceAttr(identityData, "notification_method.0.value", "team-a@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestPMSIdentityDataNotFound(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/identities",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + pmsIdentityDataStep2,
+				ExpectError: regexp.MustCompile(`No identity with name 'unknown' was found`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listPolicyIdentities(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.policyIdentities, "")
+}
@@ -294,6 +294,10 @@ func (r *pms_identity_listResource) Delete(ctx context.Context, req resource.Del
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
 
+func (r *pms_identity_listResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
+
 func (r *pms_identity_listResource) identitiesEqual(planIdentities types.List, updatedIdentitiesFromAPI []string) bool {
 	if len(planIdentities.Elements()) != len(updatedIdentitiesFromAPI) {
 		fmt.Println("Identities mismatch: length of planIdentities does not match length of updatedIdentitiesFromAPI")
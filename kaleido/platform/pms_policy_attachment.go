@@ -171,3 +171,7 @@ func (r *pms_policy_attachmentResource) Delete(ctx context.Context, req resource
 	// Call the remove-attachment API
 	_, _ = r.apiRequest(ctx, http.MethodPost, r.apiRemoveAttachmentPath(&data), api, nil, &resp.Diagnostics, Allow404())
 }
+
+func (r *pms_policy_attachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError("Import not supported", "Import is not supported for this resource, as there is no API to read back a policy attachment")
+}
@@ -0,0 +1,146 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PMSPolicyDeploymentDatasourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Environment    types.String `tfsdk:"environment"`
+	Service        types.String `tfsdk:"service"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Policy         types.String `tfsdk:"policy"`
+	PolicyVersion  types.String `tfsdk:"policy_version"`
+	Config         types.String `tfsdk:"config"`
+	CurrentVersion types.String `tfsdk:"current_version"`
+	Created        types.String `tfsdk:"created"`
+	Updated        types.String `tfsdk:"updated"`
+}
+
+func (api *PMSPolicyDeploymentAPIModel) toDatasourceData(data *PMSPolicyDeploymentDatasourceModel, diagnostics *diag.Diagnostics) {
+	var res PMSPolicyDeploymentResourceModel
+	(&pms_policy_deploymentResource{}).toData(api, &res, diagnostics)
+	data.ID = res.ID
+	data.Name = res.Name
+	data.Description = res.Description
+	data.Policy = res.Policy
+	data.PolicyVersion = res.PolicyVersion
+	data.Config = res.Config
+	data.CurrentVersion = res.CurrentVersion
+	data.Created = res.Created
+	data.Updated = res.Updated
+}
+
+func PMSPolicyDeploymentDatasourceModelFactory() datasource.DataSource {
+	return &pms_policy_deploymentDatasource{}
+}
+
+type pms_policy_deploymentDatasource struct {
+	commonDataSource
+}
+
+func (r *pms_policy_deploymentDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_pms_policy_deployment"
+}
+
+func (r *pms_policy_deploymentDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing policy deployment in a policy manager by name or ID, with its active version and configuration.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Policy Manager Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Policy Deployment ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Policy Deployment Name. Exactly one of `id` or `name` must be set",
+			},
+			"description": &schema.StringAttribute{
+				Computed: true,
+			},
+			"policy": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Policy of the active version",
+			},
+			"policy_version": &schema.StringAttribute{
+				Computed: true,
+			},
+			"config": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Configuration of the active version, as a JSON string",
+			},
+			"current_version": &schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the active version of the policy deployment",
+			},
+			"created": &schema.StringAttribute{
+				Computed: true,
+			},
+			"updated": &schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *pms_policy_deploymentDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *pms_policy_deploymentDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PMSPolicyDeploymentDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Policy deployments can be read directly by either ID or name
+	idOrName := data.ID.ValueString()
+	if idOrName == "" {
+		idOrName = data.Name.ValueString()
+	}
+	var api PMSPolicyDeploymentAPIModel
+	apiPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/policy-deployments/%s?withActive=true", data.Environment.ValueString(), data.Service.ValueString(), url.PathEscape(idOrName))
+	if ok, _ := r.apiRequest(ctx, http.MethodGet, apiPath, nil, &api, &resp.Diagnostics); !ok {
+		return
+	}
+
+	api.toDatasourceData(&data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,82 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var pmsPolicyDeploymentDataStep1 = `
+data "kaleido_platform_pms_policy_deployment" "deployment1" {
+    environment = "env1"
+    service = "service1"
+    name = "approvals"
+}
+`
+
+func TestPMSPolicyDeploymentData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	updated := time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)
+	deployment := &PMSPolicyDeploymentAPIModel{
+		ID:             "deployment1",
+		Name:           "approvals",
+		Description:    "Approval policy",
+		CurrentVersion: "version2",
+		Created:        &created,
+		Updated:        &updated,
+	}
+	mp.pmsPolicyDeployments["deployment1"] = deployment
+	mp.pmsPolicyDeployments["approvals"] = deployment
+	mp.pmsPolicyDeploymentVersions["approvals"] = map[string]*PMSPolicyDeploymentVersionAPIModel{
+		"version2": {
+			ID:            "version2",
+			Policy:        "kaleido.policy.approval",
+			PolicyVersion: "25.6.0",
+			Config:        map[string]any{"maxApprovals": 3},
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/policy-deployments/{policyDeployment}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/policy-deployments/{policyDeployment}",
+			"GET /endpoint/{env}/{service}/rest/api/v1/policy-deployments/{policyDeployment}",
+		})
+		mp.server.Close()
+	}()
+
+	deploymentData := "data.kaleido_platform_pms_policy_deployment.deployment1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + pmsPolicyDeploymentDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(deploymentData, "id", "deployment1"),
+					resource.TestCheckResourceAttr(deploymentData, "description", "Approval policy"),
+					resource.TestCheckResourceAttr(deploymentData, "policy", "kaleido.policy.approval"),
+					resource.TestCheckResourceAttr(deploymentData, "policy_version", "25.6.0"),
+					resource.TestCheckResourceAttr(deploymentData, "config", `{"maxApprovals":3}`),
+					resource.TestCheckResourceAttr(deploymentData, "current_version", "version2"),
+					resource.TestCheckResourceAttr(deploymentData, "created", "2026-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr(deploymentData, "updated", "2026-02-03T04:05:06Z"),
+				),
+			},
+		},
+	})
+}
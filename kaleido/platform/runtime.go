@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,22 +31,23 @@ import (
 )
 
 type RuntimeResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Environment         types.String `tfsdk:"environment"`
-	Type                types.String `tfsdk:"type"`
-	Name                types.String `tfsdk:"name"`
-	StackID             types.String `tfsdk:"stack_id"`
-	ConfigJSON          types.String `tfsdk:"config_json"`
-	LogLevel            types.String `tfsdk:"log_level"`
-	Size                types.String `tfsdk:"size"`
-	EnvironmentMemberID types.String `tfsdk:"environment_member_id"`
-	Stopped             types.Bool   `tfsdk:"stopped"`
-	Zone                types.String `tfsdk:"zone"`
-	SubZone             types.String `tfsdk:"sub_zone"`
-	StorageSize         types.Int64  `tfsdk:"storage_size"`
-	StorageType         types.String `tfsdk:"storage_type"`
-	ForceDelete         types.Bool   `tfsdk:"force_delete"`
-	DNSRegistrations    types.List   `tfsdk:"dns_registrations"`
+	ID                  types.String   `tfsdk:"id"`
+	Environment         types.String   `tfsdk:"environment"`
+	Type                types.String   `tfsdk:"type"`
+	Name                types.String   `tfsdk:"name"`
+	StackID             types.String   `tfsdk:"stack_id"`
+	ConfigJSON          jsonStringVal  `tfsdk:"config_json"`
+	LogLevel            types.String   `tfsdk:"log_level"`
+	Size                types.String   `tfsdk:"size"`
+	EnvironmentMemberID types.String   `tfsdk:"environment_member_id"`
+	Stopped             types.Bool     `tfsdk:"stopped"`
+	Zone                types.String   `tfsdk:"zone"`
+	SubZone             types.String   `tfsdk:"sub_zone"`
+	StorageSize         types.Int64    `tfsdk:"storage_size"`
+	StorageType         types.String   `tfsdk:"storage_type"`
+	ForceDelete         types.Bool     `tfsdk:"force_delete"`
+	DNSRegistrations    types.List     `tfsdk:"dns_registrations"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type RuntimeAPIModel struct {
@@ -77,13 +79,19 @@ type runtimeResource struct {
 	commonResource
 }
 
+// runtimeIdentity identifies a runtime by the attributes of its import ID
+var runtimeIdentity = resourceIdentity{"environment", "id"}
+
 func (r *runtimeResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_runtime"
 }
 
-func (r *runtimeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *runtimeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Runtimes are the highly-available workloads that run the function of the services. They allow for controlling the compute, networking, storage, and scalability underlying the service(s).",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -111,7 +119,8 @@ func (r *runtimeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed: true,
 			},
 			"config_json": &schema.StringAttribute{
-				Required: true,
+				Required:   true,
+				CustomType: jsonStringType{stripNulls: true},
 			},
 			"log_level": &schema.StringAttribute{
 				Optional:    true,
@@ -199,6 +208,11 @@ func (data *RuntimeResourceModel) toAPI(ctx context.Context, api *RuntimeAPIMode
 
 func (api *RuntimeAPIModel) toData(ctx context.Context, data *RuntimeResourceModel, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(api.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
+	if api.StackID != "" {
+		data.StackID = types.StringValue(api.StackID)
+	}
 	data.EnvironmentMemberID = types.StringValue(api.EnvironmentMemberID)
 	data.LogLevel = types.StringValue(api.LogLevel)
 	data.Size = types.StringValue(api.Size)
@@ -243,15 +257,23 @@ func (r *runtimeResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var data RuntimeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api RuntimeAPIModel
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics)
+	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	if !ok {
 		return
 	}
 
 	api.toData(ctx, &data, &resp.Diagnostics)
+	runtimeIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 
 }
@@ -261,6 +283,13 @@ func (r *runtimeResource) Update(ctx context.Context, req resource.UpdateRequest
 	var data RuntimeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Read full current object
 	var api RuntimeAPIModel
@@ -270,17 +299,25 @@ func (r *runtimeResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Update from plan
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	if ok, _ := r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), api, &api, &resp.Diagnostics); !ok {
+	if ok, _ := r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema)); !ok {
 		return
 	}
 
 	api.toData(ctx, &data, &resp.Diagnostics)
+	runtimeIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *runtimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data RuntimeResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api RuntimeAPIModel
 	api.ID = data.ID.ValueString()
@@ -294,14 +331,31 @@ func (r *runtimeResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	api.toData(ctx, &data, &resp.Diagnostics)
+	data.ConfigJSON = configJSONFromAPI(data.ConfigJSON, api.Config, &resp.Diagnostics)
+	runtimeIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *runtimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data RuntimeResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data), nil, nil, &resp.Diagnostics, Allow404())
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *runtimeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = runtimeIdentity.schema()
+}
+
+func (r *runtimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, runtimeIdentity...)
+}
@@ -0,0 +1,164 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RuntimeDatasourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Environment         types.String  `tfsdk:"environment"`
+	Type                types.String  `tfsdk:"type"`
+	Name                types.String  `tfsdk:"name"`
+	StackID             types.String  `tfsdk:"stack_id"`
+	ConfigJSON          jsonStringVal `tfsdk:"config_json"`
+	LogLevel            types.String  `tfsdk:"log_level"`
+	Size                types.String  `tfsdk:"size"`
+	EnvironmentMemberID types.String  `tfsdk:"environment_member_id"`
+	Status              types.String  `tfsdk:"status"`
+	Stopped             types.Bool    `tfsdk:"stopped"`
+	Zone                types.String  `tfsdk:"zone"`
+	SubZone             types.String  `tfsdk:"sub_zone"`
+	StorageSize         types.Int64   `tfsdk:"storage_size"`
+	StorageType         types.String  `tfsdk:"storage_type"`
+	DNSRegistrations    types.List    `tfsdk:"dns_registrations"`
+}
+
+func (api *RuntimeAPIModel) toDatasourceData(ctx context.Context, data *RuntimeDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(api.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
+	data.StackID = types.StringValue(api.StackID)
+	data.ConfigJSON = configJSONFromAPI(jsonStringNull(), api.Config, diagnostics)
+	data.LogLevel = types.StringValue(api.LogLevel)
+	data.Size = types.StringValue(api.Size)
+	data.EnvironmentMemberID = types.StringValue(api.EnvironmentMemberID)
+	data.Status = types.StringValue(api.Status)
+	data.Stopped = types.BoolValue(api.Stopped)
+	data.Zone = types.StringValue(api.Zone)
+	data.SubZone = types.StringValue(api.SubZone)
+	data.StorageSize = types.Int64Value(api.StorageSize)
+	data.StorageType = types.StringValue(api.StorageType)
+	var d diag.Diagnostics
+	data.DNSRegistrations, d = types.ListValueFrom(ctx, types.StringType, append([]string{}, api.DNSRegistrations...))
+	diagnostics.Append(d...)
+}
+
+func RuntimeDatasourceModelFactory() datasource.DataSource {
+	return &runtimeDatasource{}
+}
+
+type runtimeDatasource struct {
+	commonDataSource
+}
+
+func (r *runtimeDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_runtime"
+}
+
+func (r *runtimeDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing runtime in an environment by name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Runtime ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Runtime Display Name. Exactly one of `id` or `name` must be set",
+			},
+			"type": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Runtime Type",
+			},
+			"stack_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"config_json": &schema.StringAttribute{
+				Computed:   true,
+				CustomType: jsonStringType{stripNulls: true},
+			},
+			"log_level": &schema.StringAttribute{
+				Computed: true,
+			},
+			"size": &schema.StringAttribute{
+				Computed: true,
+			},
+			"environment_member_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"status": &schema.StringAttribute{
+				Computed: true,
+			},
+			"stopped": &schema.BoolAttribute{
+				Computed: true,
+			},
+			"zone": &schema.StringAttribute{
+				Computed: true,
+			},
+			"sub_zone": &schema.StringAttribute{
+				Computed: true,
+			},
+			"storage_size": &schema.Int64Attribute{
+				Computed: true,
+			},
+			"storage_type": &schema.StringAttribute{
+				Computed: true,
+			},
+			"dns_registrations": &schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *runtimeDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *runtimeDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuntimeDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api RuntimeAPIModel
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/runtimes", data.Environment.ValueString())
+	if !lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "runtime", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(ctx, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
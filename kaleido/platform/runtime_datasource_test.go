@@ -0,0 +1,74 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var runtimeDataStep1 = `
+data "kaleido_platform_runtime" "runtime1" {
+    environment = "env1"
+    id = "rt1"
+}
+`
+
+func TestRuntimeData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.runtimes["env1/rt1"] = &RuntimeAPIModel{
+		ID:      "rt1",
+		Name:    "runtime1",
+		Type:    "besu",
+		StackID: "stack1",
+		Size:    "small",
+		Status:  "ready",
+		Config: map[string]interface{}{
+			"setting1": "value1",
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		})
+		mp.server.Close()
+	}()
+
+	runtimeData := "data.kaleido_platform_runtime.runtime1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + runtimeDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(runtimeData, "name", "runtime1"),
+					resource.TestCheckResourceAttr(runtimeData, "type", "besu"),
+					resource.TestCheckResourceAttr(runtimeData, "stack_id", "stack1"),
+					resource.TestCheckResourceAttr(runtimeData, "status", "ready"),
+					resource.TestCheckResourceAttr(runtimeData, "config_json", `{"setting1":"value1"}`),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listRuntimes(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.runtimes, mux.Vars(req)["env"]+"/")
+}
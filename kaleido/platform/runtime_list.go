@@ -0,0 +1,91 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RuntimeListModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Type        types.String `tfsdk:"type"`
+	StackID     types.String `tfsdk:"stack_id"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func RuntimeListResourceFactory() list.ListResource {
+	return &runtimeResource{}
+}
+
+func (r *runtimeResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "List the runtimes in an environment, optionally filtered by type, stack, name prefix or status.",
+		Attributes: map[string]listschema.Attribute{
+			"environment": listschema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"type": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes of this type",
+			},
+			"stack_id": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes in this stack",
+			},
+			"name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes with a name starting with this prefix",
+			},
+			"status": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes with this status, such as `ready`",
+			},
+		},
+	}
+}
+
+func (r *runtimeResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config RuntimeListModel
+	diagnostics := req.Config.Get(ctx, &config)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	filters := url.Values{}
+	addListFilter(filters, "type", config.Type)
+	addListFilter(filters, "stackId", config.StackID)
+	addListFilter(filters, "status", config.Status)
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/runtimes", config.Environment.ValueString())
+	runtimes, ok := listAll[RuntimeAPIModel](ctx, r, collectionPath, filters, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	runtimes = filterByNamePrefix(runtimes, config.NamePrefix, func(v *RuntimeAPIModel) string { return v.Name })
+
+	stream.Results = listResults(ctx, req, runtimeIdentity, []types.String{config.Environment}, runtimes, func(api *RuntimeAPIModel, data *RuntimeResourceModel, diagnostics *diag.Diagnostics) {
+		api.toData(ctx, data, diagnostics)
+		data.ConfigJSON = configJSONFromAPI(data.ConfigJSON, api.Config, diagnostics)
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.runtimes["env1/rt1"] = &RuntimeAPIModel{ID: "rt1", Name: "besu1", Type: "BesuNode", StackID: "stack1", Size: "small", Status: "ready",
+		Config: map[string]interface{}{"setting1": "value1"}}
+	mp.runtimes["env1/rt2"] = &RuntimeAPIModel{ID: "rt2", Name: "besu2", Type: "BesuNode", Size: "large", Status: "ready"}
+	mp.runtimes["env1/rt3"] = &RuntimeAPIModel{ID: "rt3", Name: "gateway1", Type: "EVMGateway", Status: "ready"}
+	mp.runtimes["env2/rt4"] = &RuntimeAPIModel{ID: "rt4", Name: "besu1", Type: "BesuNode", Status: "ready"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, RuntimeListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"name_prefix": tftypes.NewValue(tftypes.String, "besu"),
+	}, true, 0)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "besu1", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "rt1"}, testListResultAttrs(t, results[0].Identity, "environment", "id"))
+	assert.Equal(t, map[string]string{
+		"environment": "env1",
+		"id":          "rt1",
+		"name":        "besu1",
+		"type":        "BesuNode",
+		"stack_id":    "stack1",
+		"size":        "small",
+		"config_json": `{"setting1":"value1"}`,
+	}, testListResultAttrs(t, results[0].Resource, "environment", "id", "name", "type", "stack_id", "size", "config_json"))
+	assert.Equal(t, "besu2", results[1].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "rt2"}, testListResultAttrs(t, results[1].Identity, "environment", "id"))
+	assert.Equal(t, map[string]string{"size": "large"}, testListResultAttrs(t, results[1].Resource, "size"))
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/runtimes",
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RuntimeRestartActionModel struct {
+	Environment  types.String `tfsdk:"environment"`
+	Runtime      types.String `tfsdk:"runtime"`
+	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+}
+
+func RuntimeRestartActionFactory() action.Action {
+	return &runtime_restartAction{}
+}
+
+type runtime_restartAction struct {
+	commonAction
+}
+
+func (r *runtime_restartAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_runtime_restart"
+}
+
+func (r *runtime_restartAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a running runtime, by stopping it and starting it again. A runtime that is stopped is left stopped.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"runtime": &schema.StringAttribute{
+				Required:    true,
+				Description: "Runtime ID",
+			},
+			"wait_for_ready": &schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set to `false` to return once the runtime has been started, without waiting for it to be ready. Defaults to `true`.",
+			},
+		},
+	}
+}
+
+// waitForStopped waits for the runtime to report it has stopped, before it is started again
+func (r *runtime_restartAction) waitForStopped(ctx context.Context, path string, diagnostics *diag.Diagnostics) {
+	r.waitForStatus(ctx, fmt.Sprintf("stopped-check %s", path), path, "stopped", diagnostics, func(attemptDiags *diag.Diagnostics) (string, bool, error) {
+		var api RuntimeAPIModel
+		if ok, _ := r.apiRequest(ctx, http.MethodGet, path, nil, &api, attemptDiags); !ok {
+			return "", false, fmt.Errorf("stopped-check failed") // already set in diag
+		}
+		if !strings.EqualFold(api.Status, "stopped") {
+			return api.Status, true, fmt.Errorf("not stopped yet")
+		}
+		return api.Status, false, nil
+	})
+}
+
+func (r *runtime_restartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RuntimeRestartActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultUpdateTimeout)
+	defer cancel()
+
+	path := fmt.Sprintf("/api/v1/environments/%s/runtimes/%s", data.Environment.ValueString(), data.Runtime.ValueString())
+	var api RuntimeAPIModel
+	if ok, _ := r.apiRequest(ctx, http.MethodGet, path, nil, &api, &resp.Diagnostics); !ok {
+		return
+	}
+
+	// Starting a stopped runtime would undo the stopped attribute of the runtime resource
+	if api.Stopped {
+		resp.Diagnostics.AddWarning("runtime not restarted", fmt.Sprintf("Runtime %s is stopped. Set stopped to false on the runtime to start it", api.Name))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping runtime %s", api.Name)})
+	api.Stopped = true
+	if ok, _ := r.apiRequest(ctx, http.MethodPut, path, api, &api, &resp.Diagnostics); !ok {
+		return
+	}
+	r.waitForStopped(ctx, path, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Starting runtime %s", api.Name)})
+	api.Stopped = false
+	if ok, _ := r.apiRequest(ctx, http.MethodPut, path, api, &api, &resp.Diagnostics); !ok {
+		return
+	}
+	if data.WaitForReady.IsNull() || data.WaitForReady.ValueBool() {
+		r.waitForReadyStatus(ctx, path, &resp.Diagnostics)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeRestartAction(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.runtimes["env1/rt1"] = &RuntimeAPIModel{ID: "rt1", Name: "runtime1", Type: "besu", Status: "ready"}
+	initialDelay := 10 * time.Millisecond
+	pd := &kaleidobase.ProviderData{
+		Platform:       resty.New().SetBaseURL(mp.server.URL),
+		RetryOverrides: kaleidobase.RetryOverrides{InitialDelay: &initialDelay},
+	}
+
+	progress, diags := testInvokeAction(t, RuntimeRestartActionFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"runtime":     tftypes.NewValue(tftypes.String, "rt1"),
+	})
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"Stopping runtime runtime1", "Starting runtime runtime1"}, progress)
+	assert.False(t, mp.runtimes["env1/rt1"].Stopped)
+	assert.Equal(t, "ready", mp.runtimes["env1/rt1"].Status)
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		"PUT /api/v1/environments/{env}/runtimes/{runtime}",
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		"PUT /api/v1/environments/{env}/runtimes/{runtime}",
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+	})
+}
+
+func TestRuntimeRestartActionStopped(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.runtimes["env1/rt1"] = &RuntimeAPIModel{ID: "rt1", Name: "runtime1", Type: "besu", Status: "stopped", Stopped: true}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	progress, diags := testInvokeAction(t, RuntimeRestartActionFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"runtime":     tftypes.NewValue(tftypes.String, "rt1"),
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, diag.Diagnostics{diag.NewWarningDiagnostic("runtime not restarted", "Runtime runtime1 is stopped. Set stopped to false on the runtime to start it")}, diags)
+	assert.Empty(t, progress)
+	assert.True(t, mp.runtimes["env1/rt1"].Stopped)
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/runtimes/{runtime}",
+	})
+}
+
+func TestRuntimeRestartActionNotFound(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	_, diags := testInvokeAction(t, RuntimeRestartActionFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"runtime":     tftypes.NewValue(tftypes.String, "rt1"),
+	})
+	assert.True(t, diags.HasError())
+}
@@ -16,6 +16,7 @@ package platform
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -96,6 +97,7 @@ func TestRuntime1(t *testing.T) {
 			"GET /api/v1/environments/{env}/runtimes/{runtime}",
 			"PUT /api/v1/environments/{env}/runtimes/{runtime}",
 			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
 			"DELETE /api/v1/environments/{env}/runtimes/{runtime}",
 			"GET /api/v1/environments/{env}/runtimes/{runtime}",
 		})
@@ -227,6 +229,116 @@ func TestRuntime1(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      runtime1Resource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// storage is only tracked when configured
+				ImportStateVerifyIgnore: []string{"storage_size", "storage_type"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[runtime1Resource].Primary.Attributes["id"]
+					return fmt.Sprintf("env1/%s", id), nil
+				},
+			},
+		},
+	})
+}
+
+var runtimeConfigDriftStep1 = `
+resource "kaleido_platform_runtime" "runtime1" {
+    environment = "env1"
+    type = "besu"
+    name = "runtime1"
+    config_json = jsonencode({
+        "setting1": "value1",
+        "nested": {
+            "setting2": 2
+        }
+    })
+}
+`
+
+func TestRuntimeConfigDrift(t *testing.T) {
+
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /api/v1/environments/{env}/runtimes",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+			"DELETE /api/v1/environments/{env}/runtimes/{runtime}",
+			"GET /api/v1/environments/{env}/runtimes/{runtime}",
+		})
+		mp.server.Close()
+	}()
+
+	runtime1Resource := "kaleido_platform_runtime.runtime1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + runtimeConfigDriftStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(runtime1Resource, "config_json", `{"nested":{"setting2":2},"setting1":"value1"}`),
+				),
+			},
+			{
+				// Defaults injected by the server are not drift
+				PreConfig: func() {
+					for _, rt := range mp.runtimes {
+						rt.Config["serverDefault"] = "injected"
+						rt.Config["nested"].(map[string]interface{})["nestedDefault"] = true
+					}
+				},
+				Config:   providerConfig + runtimeConfigDriftStep1,
+				PlanOnly: true,
+			},
+			{
+				// A configured value changed outside of Terraform is drift
+				PreConfig: func() {
+					for _, rt := range mp.runtimes {
+						rt.Config["setting1"] = "changed"
+					}
+				},
+				Config:             providerConfig + runtimeConfigDriftStep1,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+var runtimeConfigErrorStep1 = `
+resource "kaleido_platform_runtime" "runtime1" {
+    environment = "env1"
+    type = "besu"
+    name = "runtime1"
+    config_json = jsonencode({
+        "unsupportedSetting": "value1"
+    })
+}
+`
+
+func TestRuntimeConfigError(t *testing.T) {
+
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /api/v1/environments/{env}/runtimes",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + runtimeConfigErrorStep1,
+				ExpectError: regexp.MustCompile(`(?s)POST failed: unsupported config setting 'unsupportedSetting'.*Error code: KA010425.*Field: config.unsupportedSetting.*Request ID: req1`),
+			},
 		},
 	})
 }
@@ -237,14 +349,25 @@ func (mp *mockPlatform) getRuntime(res http.ResponseWriter, req *http.Request) {
 		mp.respond(res, nil, 404)
 	} else {
 		mp.respond(res, rt, 200)
-		// Next time will return ready
+		// Next time will return ready, or stopped once the runtime has been stopped
 		rt.Status = "ready"
+		if rt.Stopped {
+			rt.Status = "stopped"
+		}
 	}
 }
 
 func (mp *mockPlatform) postRuntime(res http.ResponseWriter, req *http.Request) {
 	var rt RuntimeAPIModel
 	mp.getBody(req, &rt)
+	if _, ok := rt.Config["unsupportedSetting"]; ok {
+		mp.respond(res, &PlatformErrorBody{
+			Error:     "KA010425: unsupported config setting 'unsupportedSetting'",
+			Field:     "config.unsupportedSetting",
+			RequestID: "req1",
+		}, 400)
+		return
+	}
 	rt.ID = nanoid.New()
 	now := time.Now().UTC()
 	rt.Created = &now
@@ -0,0 +1,165 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RuntimesDatasourceModel struct {
+	Environment types.String                  `tfsdk:"environment"`
+	Type        types.String                  `tfsdk:"type"`
+	StackID     types.String                  `tfsdk:"stack_id"`
+	NamePrefix  types.String                  `tfsdk:"name_prefix"`
+	Status      types.String                  `tfsdk:"status"`
+	Runtimes    []RuntimesDatasourceItemModel `tfsdk:"runtimes"`
+}
+
+type RuntimesDatasourceItemModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	Type                types.String  `tfsdk:"type"`
+	StackID             types.String  `tfsdk:"stack_id"`
+	EnvironmentMemberID types.String  `tfsdk:"environment_member_id"`
+	Status              types.String  `tfsdk:"status"`
+	Size                types.String  `tfsdk:"size"`
+	Zone                types.String  `tfsdk:"zone"`
+	Stopped             types.Bool    `tfsdk:"stopped"`
+	ConfigJSON          jsonStringVal `tfsdk:"config_json"`
+}
+
+func (api *RuntimeAPIModel) toListItemData(diagnostics *diag.Diagnostics) RuntimesDatasourceItemModel {
+	return RuntimesDatasourceItemModel{
+		ID:                  types.StringValue(api.ID),
+		Name:                types.StringValue(api.Name),
+		Type:                types.StringValue(api.Type),
+		StackID:             types.StringValue(api.StackID),
+		EnvironmentMemberID: types.StringValue(api.EnvironmentMemberID),
+		Status:              types.StringValue(api.Status),
+		Size:                types.StringValue(api.Size),
+		Zone:                types.StringValue(api.Zone),
+		Stopped:             types.BoolValue(api.Stopped),
+		ConfigJSON:          configJSONFromAPI(jsonStringNull(), api.Config, diagnostics),
+	}
+}
+
+func RuntimesDatasourceModelFactory() datasource.DataSource {
+	return &runtimesDatasource{}
+}
+
+type runtimesDatasource struct {
+	commonDataSource
+}
+
+func (r *runtimesDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_runtimes"
+}
+
+func (r *runtimesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the runtimes in an environment, optionally filtered by type, stack, name prefix or status.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"type": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes of this type",
+			},
+			"stack_id": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes in this stack",
+			},
+			"name_prefix": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes with a name starting with this prefix",
+			},
+			"status": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list runtimes with this status, such as `ready`",
+			},
+			"runtimes": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"stack_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"environment_member_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"status": &schema.StringAttribute{
+							Computed: true,
+						},
+						"size": &schema.StringAttribute{
+							Computed: true,
+						},
+						"zone": &schema.StringAttribute{
+							Computed: true,
+						},
+						"stopped": &schema.BoolAttribute{
+							Computed: true,
+						},
+						"config_json": &schema.StringAttribute{
+							Computed:   true,
+							CustomType: jsonStringType{stripNulls: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *runtimesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuntimesDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := url.Values{}
+	addListFilter(filters, "type", data.Type)
+	addListFilter(filters, "stackId", data.StackID)
+	addListFilter(filters, "status", data.Status)
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/runtimes", data.Environment.ValueString())
+	runtimes, ok := listAll[RuntimeAPIModel](ctx, &r.commonDataSource, collectionPath, filters, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	runtimes = filterByNamePrefix(runtimes, data.NamePrefix, func(v *RuntimeAPIModel) string { return v.Name })
+
+	data.Runtimes = make([]RuntimesDatasourceItemModel, len(runtimes))
+	for i := range runtimes {
+		data.Runtimes[i] = runtimes[i].toListItemData(&resp.Diagnostics)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
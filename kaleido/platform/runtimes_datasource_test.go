@@ -0,0 +1,58 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var runtimesDataStep1 = `
+data "kaleido_platform_runtimes" "runtimes" {
+    environment = "env1"
+    name_prefix = "besu"
+}
+`
+
+func TestRuntimesData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.runtimes["env1/rt1"] = &RuntimeAPIModel{ID: "rt1", Name: "besu1", Type: "BesuNode", Size: "small", Status: "ready"}
+	mp.runtimes["env1/rt2"] = &RuntimeAPIModel{ID: "rt2", Name: "besu2", Type: "BesuNode", Size: "large", Status: "ready"}
+	mp.runtimes["env1/rt3"] = &RuntimeAPIModel{ID: "rt3", Name: "gateway1", Type: "EVMGateway", Status: "ready"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/runtimes",
+			"GET /api/v1/environments/{env}/runtimes",
+			"GET /api/v1/environments/{env}/runtimes",
+		})
+		mp.server.Close()
+	}()
+
+	runtimesData := "data.kaleido_platform_runtimes.runtimes"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + runtimesDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(runtimesData, "runtimes.#", "2"),
+					resource.TestCheckResourceAttr(runtimesData, "runtimes.0.name", "besu1"),
+					resource.TestCheckResourceAttr(runtimesData, "runtimes.1.size", "large"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hyperledger/firefly-signer/pkg/ethtypes"
+	"github.com/hyperledger/firefly-signer/pkg/secp256k1"
+)
+
+func Secp256k1AddressFromPubkeyFunctionFactory() function.Function {
+	return &secp256k1_address_from_pubkeyFunction{}
+}
+
+type secp256k1_address_from_pubkeyFunction struct{}
+
+func (f *secp256k1_address_from_pubkeyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "secp256k1_address_from_pubkey"
+}
+
+func (f *secp256k1_address_from_pubkeyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the EVM address of a secp256k1 public key",
+		Description: "Returns the lower case, `0x` prefixed EVM address of a secp256k1 public key, such as the `public_key` of a `kaleido_platform_secp256k1_node_key`. The public key is hex, with or without a `0x` prefix, and can be compressed (33 bytes), uncompressed (65 bytes), or uncompressed without the `04` prefix byte (64 bytes).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "secp256k1 public key as hex",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *secp256k1_address_from_pubkeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+	resp.Error = req.Arguments.Get(ctx, &publicKey)
+	if resp.Error != nil {
+		return
+	}
+
+	b, err := ethtypes.NewHexBytes0xPrefix(publicKey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid public key: %s", err))
+		return
+	}
+	if len(b) == 64 {
+		// Ethereum tooling commonly omits the prefix byte of an uncompressed key
+		b = append([]byte{0x04}, b...)
+	}
+	pubKey, err := btcec.ParsePubKey(b)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid public key: %s", err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, secp256k1.PublicKeyToAddress(pubKey).String())
+}
@@ -0,0 +1,49 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hyperledger/firefly-signer/pkg/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecp256k1AddressFromPubkeyFunction(t *testing.T) {
+	keypair, err := secp256k1.GenerateSecp256k1KeyPair()
+	assert.NoError(t, err)
+	expected := types.StringValue(keypair.Address.String())
+
+	for _, publicKey := range []string{
+		hex.EncodeToString(keypair.PublicKeyBytes()),
+		"0x" + hex.EncodeToString(keypair.PublicKey.SerializeUncompressed()),
+		hex.EncodeToString(keypair.PublicKey.SerializeCompressed()),
+	} {
+		result, funcErr := testRunFunction(t, Secp256k1AddressFromPubkeyFunctionFactory(), publicKey)
+		assert.Nil(t, funcErr)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func TestSecp256k1AddressFromPubkeyFunctionInvalid(t *testing.T) {
+	_, funcErr := testRunFunction(t, Secp256k1AddressFromPubkeyFunctionFactory(), "not hex")
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "invalid public key", funcErr.Text)
+
+	_, funcErr = testRunFunction(t, Secp256k1AddressFromPubkeyFunctionFactory(), "0x1234")
+	assert.NotNil(t, funcErr)
+	assert.Regexp(t, "invalid public key", funcErr.Text)
+}
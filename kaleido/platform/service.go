@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,22 +32,25 @@ import (
 )
 
 type ServiceResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Environment         types.String `tfsdk:"environment"`
-	Runtime             types.String `tfsdk:"runtime"`
-	Type                types.String `tfsdk:"type"`
-	Name                types.String `tfsdk:"name"`
-	DatabaseName        types.String `tfsdk:"database_name"`
-	StackID             types.String `tfsdk:"stack_id"`
-	EnvironmentMemberID types.String `tfsdk:"environment_member_id"`
-	ConfigJSON          types.String `tfsdk:"config_json"`
-	Endpoints           types.Map    `tfsdk:"endpoints"`
-	Hostnames           types.Map    `tfsdk:"hostnames"`
-	Filesets            types.Map    `tfsdk:"file_sets"`
-	Credsets            types.Map    `tfsdk:"cred_sets"`
-	ConnectivityJSON    types.String `tfsdk:"connectivity_json"`
-	ForceDelete         types.Bool   `tfsdk:"force_delete"`
-	WaitForReady        types.Bool   `tfsdk:"wait_for_ready"`
+	ID                  types.String   `tfsdk:"id"`
+	Environment         types.String   `tfsdk:"environment"`
+	Runtime             types.String   `tfsdk:"runtime"`
+	Type                types.String   `tfsdk:"type"`
+	Name                types.String   `tfsdk:"name"`
+	DatabaseName        types.String   `tfsdk:"database_name"`
+	StackID             types.String   `tfsdk:"stack_id"`
+	EnvironmentMemberID types.String   `tfsdk:"environment_member_id"`
+	ConfigJSON          jsonStringVal  `tfsdk:"config_json"`
+	Endpoints           types.Map      `tfsdk:"endpoints"`
+	Hostnames           types.Map      `tfsdk:"hostnames"`
+	Filesets            types.Map      `tfsdk:"file_sets"`
+	Credsets            types.Map      `tfsdk:"cred_sets"`
+	CredsetsWO          types.Map      `tfsdk:"cred_sets_wo"`
+	CredsetsWOVersion   types.Int64    `tfsdk:"cred_sets_wo_version"`
+	ConnectivityJSON    jsonStringVal  `tfsdk:"connectivity_json"`
+	ForceDelete         types.Bool     `tfsdk:"force_delete"`
+	WaitForReady        types.Bool     `tfsdk:"wait_for_ready"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ServiceAPIModel struct {
@@ -103,13 +107,19 @@ type serviceResource struct {
 	commonResource
 }
 
+// serviceIdentity identifies a service by the attributes of its import ID
+var serviceIdentity = resourceIdentity{"environment", "id"}
+
 func (r *serviceResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_service"
 }
 
-func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *serviceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Each capability of the Kaleido platform is made available as a service.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -147,7 +157,8 @@ func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed: true,
 			},
 			"config_json": &schema.StringAttribute{
-				Required: true,
+				Required:   true,
+				CustomType: jsonStringType{stripNulls: true},
 			},
 			"endpoints": &schema.MapNestedAttribute{
 				Computed: true,
@@ -234,8 +245,11 @@ func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
+			"cred_sets_wo":         credSetsWriteOnlyAttribute(),
+			"cred_sets_wo_version": writeOnlyVersionAttribute("cred_sets_wo"),
 			"connectivity_json": &schema.StringAttribute{
-				Computed: true,
+				CustomType: jsonStringType{},
+				Computed:   true,
 			},
 			"force_delete": &schema.BoolAttribute{
 				Optional:    true,
@@ -325,44 +339,7 @@ func (data *ServiceResourceModel) toAPI(ctx context.Context, api *ServiceAPIMode
 	}
 	// credsets (complex nested structure)
 	if !data.Credsets.IsNull() {
-		basicAuthAttrs := map[string]attr.Type{
-			"username": types.StringType,
-			"password": types.StringType,
-		}
-		keyAttrs := map[string]attr.Type{
-			"value": types.StringType,
-		}
-		api.Credsets = make(map[string]*CredSetAPI)
-		tfCredsets := data.Credsets.Elements()
-		for credSetName, tfCredSetAttr := range tfCredsets {
-			tfCredSet := tfCredSetAttr.(types.Object)
-			tfCredSetAttrs := tfCredSet.Attributes()
-			crType := tfCredSetAttrs["type"].(types.String).ValueString()
-			cr := &CredSetAPI{
-				Name: credSetName,
-				Type: crType,
-			}
-			if crType == "basic_auth" && !tfCredSetAttrs["basic_auth"].IsNull() {
-				tfBasicAuth, d := types.ObjectValueFrom(ctx, basicAuthAttrs, tfCredSetAttrs["basic_auth"])
-				diagnostics.Append(d...)
-				tfBasicAuthAttrs := tfBasicAuth.Attributes()
-				cr.BasicAuth = &CredSetBasicAuthAPI{
-					Username: tfBasicAuthAttrs["username"].(types.String).ValueString(),
-					Password: tfBasicAuthAttrs["password"].(types.String).ValueString(),
-				}
-			} else if crType == "key" && !tfCredSetAttrs["key"].IsNull() {
-				tfKey, d := types.ObjectValueFrom(ctx, keyAttrs, tfCredSetAttrs["key"])
-				diagnostics.Append(d...)
-				tfKeyAttrs := tfKey.Attributes()
-				cr.Key = &CredSetKeyAPI{
-					Value: tfKeyAttrs["value"].(types.String).ValueString(),
-				}
-			} else {
-				diagnostics.AddError("missing credential", fmt.Sprintf("must specify key/basic_auth as appropriate for type '%s'", crType))
-				return
-			}
-			api.Credsets[credSetName] = cr
-		}
+		api.Credsets = credSetsToAPI(ctx, data.Credsets, diagnostics)
 	}
 
 	//connectivity is computed. So only goes from api to data not via versa
@@ -370,16 +347,27 @@ func (data *ServiceResourceModel) toAPI(ctx context.Context, api *ServiceAPIMode
 
 func (api *ServiceAPIModel) toData(data *ServiceResourceModel, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(api.ID)
+	data.Runtime = types.StringValue(api.Runtime.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
+	if api.StackID != "" {
+		data.StackID = types.StringValue(api.StackID)
+	}
 	data.EnvironmentMemberID = types.StringValue(api.EnvironmentMemberID)
 	if api.DatabaseName != "" {
 		data.DatabaseName = types.StringValue(api.DatabaseName)
 	}
+	data.Endpoints = api.endpointsToData(diagnostics)
+	data.ConnectivityJSON = api.connectivityToData(diagnostics)
+}
+
+var serviceEndpointAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"urls": types.ListType{ElemType: types.StringType},
+}
+
+func (api *ServiceAPIModel) endpointsToData(diagnostics *diag.Diagnostics) types.Map {
 	endpoints := map[string]attr.Value{}
-	endpointAttrTypes := map[string]attr.Type{
-		"type": types.StringType,
-		"urls": types.ListType{ElemType: types.StringType},
-	}
-	var d diag.Diagnostics
 	for k, e := range api.Endpoints {
 		endpoint := map[string]attr.Value{}
 		endpoint["type"] = types.StringValue(e.Type)
@@ -390,27 +378,27 @@ func (api *ServiceAPIModel) toData(data *ServiceResourceModel, diagnostics *diag
 		tfURLs, d := types.ListValue(types.StringType, urls)
 		diagnostics.Append(d...)
 		endpoint["urls"] = tfURLs
-		tfEndpoint, d := types.ObjectValue(endpointAttrTypes, endpoint)
+		tfEndpoint, d := types.ObjectValue(serviceEndpointAttrTypes, endpoint)
 		diagnostics.Append(d...)
 		endpoints[k] = tfEndpoint
 	}
-	data.Endpoints, d = types.MapValue(types.ObjectType{
-		AttrTypes: endpointAttrTypes,
+	tfEndpoints, d := types.MapValue(types.ObjectType{
+		AttrTypes: serviceEndpointAttrTypes,
 	}, endpoints)
 	diagnostics.Append(d...)
+	return tfEndpoints
+}
 
-	//connectivity
-	if api.StatusDetails.Connectivity != nil {
-		d, err := json.Marshal(api.StatusDetails.Connectivity)
-		if err != nil {
-			diagnostics.AddError("failed to marshal connectivity", err.Error())
-			return
-		}
-		connectivityJSON := string(d)
-		data.ConnectivityJSON = types.StringValue(connectivityJSON)
-	} else {
-		data.ConnectivityJSON = types.StringValue("")
+func (api *ServiceAPIModel) connectivityToData(diagnostics *diag.Diagnostics) jsonStringVal {
+	if api.StatusDetails.Connectivity == nil {
+		return newJSONString("")
+	}
+	d, err := json.Marshal(api.StatusDetails.Connectivity)
+	if err != nil {
+		diagnostics.AddError("failed to marshal connectivity", err.Error())
+		return newJSONString("")
 	}
+	return newJSONString(string(d))
 }
 
 func (r *serviceResource) apiPath(data *ServiceResourceModel) string {
@@ -430,10 +418,18 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var data ServiceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api ServiceAPIModel
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics)
+	credSetsFromConfig(ctx, req.Config, &api.Credsets, &resp.Diagnostics)
+	ok, _ := r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	if !ok {
 		return
 	}
@@ -444,6 +440,7 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 		r.waitForReadyStatus(ctx, r.apiPath(&data), &resp.Diagnostics)
 	} else {
 		// no need to re-read from api, so just set the state and return
+		serviceIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 		return
 	}
@@ -456,6 +453,7 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	api.toData(&data, &resp.Diagnostics) // need the latest status after the readiness check completes, to extract generated values
+	serviceIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -464,6 +462,13 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	var data ServiceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Read full current object
 	var api ServiceAPIModel
@@ -473,7 +478,8 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Update from plan
 	data.toAPI(ctx, &api, &resp.Diagnostics)
-	if ok, _ := r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), api, &api, &resp.Diagnostics); !ok {
+	credSetsFromConfig(ctx, req.Config, &api.Credsets, &resp.Diagnostics)
+	if ok, _ := r.apiRequest(ctx, http.MethodPut, r.apiPath(&data), api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema)); !ok {
 		return
 	}
 
@@ -484,12 +490,20 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	api.toData(&data, &resp.Diagnostics) // need the latest status after the readiness check completes, to extract generated values
+	serviceIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data ServiceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api ServiceAPIModel
 	api.ID = data.ID.ValueString()
@@ -503,14 +517,31 @@ func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	api.toData(&data, &resp.Diagnostics)
+	data.ConfigJSON = configJSONFromAPI(data.ConfigJSON, api.Config, &resp.Diagnostics)
+	serviceIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data ServiceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data), nil, nil, &resp.Diagnostics, Allow404())
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *serviceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = serviceIdentity.schema()
+}
+
+func (r *serviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, serviceIdentity...)
+}
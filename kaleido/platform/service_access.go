@@ -30,11 +30,11 @@ import (
 )
 
 type ServiceAccessResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	GroupID         types.String `tfsdk:"group_id"`
-	ServiceID       types.String `tfsdk:"service_id"`
-	ApplicationID   types.String `tfsdk:"application_id"`
-	PermissionsJSON types.String `tfsdk:"permissions_json"`
+	ID              types.String  `tfsdk:"id"`
+	GroupID         types.String  `tfsdk:"group_id"`
+	ServiceID       types.String  `tfsdk:"service_id"`
+	ApplicationID   types.String  `tfsdk:"application_id"`
+	PermissionsJSON jsonStringVal `tfsdk:"permissions_json"`
 }
 
 type ServiceAccessAPIModel struct {
@@ -83,6 +83,7 @@ func (r *serviceAccessResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description:   "Application ID. Specify either group_id or application_id",
 			},
 			"permissions_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("{}"),
@@ -116,10 +117,10 @@ func (api *ServiceAccessAPIModel) toData(data *ServiceAccessResourceModel) {
 
 	if len(api.Permissions) > 0 {
 		permissionsBytes, _ := json.Marshal(api.Permissions)
-		data.PermissionsJSON = types.StringValue(string(permissionsBytes))
+		data.PermissionsJSON = newJSONString(string(permissionsBytes))
 	} else {
 		// Normalize empty/nil to "{}" to match schema default and avoid plan/state drift
-		data.PermissionsJSON = types.StringValue("{}")
+		data.PermissionsJSON = newJSONString("{}")
 	}
 
 	data.ServiceID = types.StringValue(api.ServiceID)
@@ -199,3 +200,7 @@ func (r *serviceAccessResource) Delete(ctx context.Context, req resource.DeleteR
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *serviceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "service_id", "id")
+}
@@ -185,3 +185,7 @@ func (r *serviceAccessPolicyResource) Delete(ctx context.Context, req resource.D
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *serviceAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "service_id", "id")
+}
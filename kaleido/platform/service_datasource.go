@@ -0,0 +1,168 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ServiceDatasourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Environment         types.String  `tfsdk:"environment"`
+	Runtime             types.String  `tfsdk:"runtime"`
+	Type                types.String  `tfsdk:"type"`
+	Name                types.String  `tfsdk:"name"`
+	DatabaseName        types.String  `tfsdk:"database_name"`
+	StackID             types.String  `tfsdk:"stack_id"`
+	EnvironmentMemberID types.String  `tfsdk:"environment_member_id"`
+	Status              types.String  `tfsdk:"status"`
+	ConfigJSON          jsonStringVal `tfsdk:"config_json"`
+	Endpoints           types.Map     `tfsdk:"endpoints"`
+	Hostnames           types.Map     `tfsdk:"hostnames"`
+	ConnectivityJSON    jsonStringVal `tfsdk:"connectivity_json"`
+}
+
+func (api *ServiceAPIModel) toDatasourceData(ctx context.Context, data *ServiceDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(api.ID)
+	data.Runtime = types.StringValue(api.Runtime.ID)
+	data.Type = types.StringValue(api.Type)
+	data.Name = types.StringValue(api.Name)
+	data.DatabaseName = types.StringValue(api.DatabaseName)
+	data.StackID = types.StringValue(api.StackID)
+	data.EnvironmentMemberID = types.StringValue(api.EnvironmentMemberID)
+	data.Status = types.StringValue(api.Status)
+	data.ConfigJSON = configJSONFromAPI(jsonStringNull(), api.Config, diagnostics)
+	data.Endpoints = api.endpointsToData(diagnostics)
+	hostnames := api.Hostnames
+	if hostnames == nil {
+		hostnames = map[string][]string{}
+	}
+	var d diag.Diagnostics
+	data.Hostnames, d = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, hostnames)
+	diagnostics.Append(d...)
+	data.ConnectivityJSON = api.connectivityToData(diagnostics)
+}
+
+func ServiceDatasourceModelFactory() datasource.DataSource {
+	return &serviceDatasource{}
+}
+
+type serviceDatasource struct {
+	commonDataSource
+}
+
+func (r *serviceDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_service"
+}
+
+func (r *serviceDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing service in an environment by name or ID, including its endpoints and connectivity.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Service ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Service Display Name. Exactly one of `id` or `name` must be set",
+			},
+			"runtime": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Runtime ID",
+			},
+			"type": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Service Type",
+			},
+			"database_name": &schema.StringAttribute{
+				Computed: true,
+			},
+			"stack_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"environment_member_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"status": &schema.StringAttribute{
+				Computed: true,
+			},
+			"config_json": &schema.StringAttribute{
+				Computed:   true,
+				CustomType: jsonStringType{stripNulls: true},
+			},
+			"endpoints": &schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"urls": &schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"hostnames": &schema.MapAttribute{
+				Computed: true,
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"connectivity_json": &schema.StringAttribute{
+				CustomType: jsonStringType{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *serviceDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *serviceDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api ServiceAPIModel
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/services", data.Environment.ValueString())
+	if !lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "service", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(ctx, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,116 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var serviceDataStep1 = `
+data "kaleido_platform_service" "service1" {
+    environment = "env1"
+    name = "service1"
+}
+`
+
+var serviceDataMissingStep1 = `
+data "kaleido_platform_service" "service1" {
+    environment = "env1"
+    name = "service2"
+}
+`
+
+func TestServiceData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.services["env1/svc1"] = &ServiceAPIModel{
+		ID:      "svc1",
+		Name:    "service1",
+		Type:    "BesuNode",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt1"},
+		StackID: "stack1",
+		Status:  "ready",
+		Config:  map[string]interface{}{},
+		Endpoints: map[string]ServiceAPIEndpoint{
+			"rpc": {
+				Type: "http",
+				URLS: []string{"https://example.com/rpc"},
+			},
+		},
+		StatusDetails: ServiceStatusDetails{
+			Connectivity: &Connectivity{
+				Identity: "node1",
+				Endpoints: []Endpoint{
+					{Host: "10.0.0.1", Port: 30303, Protocol: "TCP"},
+				},
+			},
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/services",
+			"GET /api/v1/environments/{env}/services",
+			"GET /api/v1/environments/{env}/services",
+		})
+		mp.server.Close()
+	}()
+
+	serviceData := "data.kaleido_platform_service.service1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + serviceDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceData, "id", "svc1"),
+					resource.TestCheckResourceAttr(serviceData, "runtime", "rt1"),
+					resource.TestCheckResourceAttr(serviceData, "stack_id", "stack1"),
+					resource.TestCheckResourceAttr(serviceData, "status", "ready"),
+					resource.TestCheckResourceAttr(serviceData, "endpoints.rpc.urls.0", "https://example.com/rpc"),
+					resource.TestCheckResourceAttr(serviceData, "connectivity_json", `{"identity":"node1","endpoints":[{"host":"10.0.0.1","port":30303,"protocol":"TCP"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceDataNotFound(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/services",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + serviceDataMissingStep1,
+				ExpectError: regexp.MustCompile(`No service with name 'service2' was found`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listServices(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.services, mux.Vars(req)["env"]+"/")
+}
@@ -0,0 +1,99 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.services["env1/svc1"] = &ServiceAPIModel{ID: "svc1", Name: "node1", Type: "BesuNode", StackID: "stack1", Status: "ready",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt1"}, Config: map[string]interface{}{"setting1": "value1"}}
+	mp.services["env1/svc2"] = &ServiceAPIModel{ID: "svc2", Name: "node2", Type: "BesuNode", Status: "ready",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt2"}}
+	mp.services["env1/svc3"] = &ServiceAPIModel{ID: "svc3", Name: "signer1", Type: "EVMGateway", Status: "ready",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt3"}}
+	mp.services["env2/svc4"] = &ServiceAPIModel{ID: "svc4", Name: "node1", Type: "BesuNode", Status: "ready"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, ServiceListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"type":        tftypes.NewValue(tftypes.String, "BesuNode"),
+	}, true, 0)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "node1", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "svc1"}, testListResultAttrs(t, results[0].Identity, "environment", "id"))
+	assert.Equal(t, map[string]string{
+		"environment": "env1",
+		"id":          "svc1",
+		"name":        "node1",
+		"type":        "BesuNode",
+		"runtime":     "rt1",
+		"stack_id":    "stack1",
+		"config_json": `{"setting1":"value1"}`,
+	}, testListResultAttrs(t, results[0].Resource, "environment", "id", "name", "type", "runtime", "stack_id", "config_json"))
+	assert.Equal(t, "node2", results[1].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "svc2"}, testListResultAttrs(t, results[1].Identity, "environment", "id"))
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/services",
+	})
+}
+
+func TestServiceListLimit(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	mp.services["env1/svc1"] = &ServiceAPIModel{ID: "svc1", Name: "node1", Type: "BesuNode"}
+	mp.services["env1/svc2"] = &ServiceAPIModel{ID: "svc2", Name: "node2", Type: "BesuNode"}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, ServiceListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+	}, false, 1)
+	assert.Len(t, results, 1)
+	assert.False(t, results[0].Diagnostics.HasError(), results[0].Diagnostics)
+	assert.Equal(t, "node1", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "id": "svc1"}, testListResultAttrs(t, results[0].Identity, "environment", "id"))
+	assert.True(t, results[0].Resource.Raw.IsNull())
+	mp.checkClearCalls([]string{
+		"GET /api/v1/environments/{env}/services",
+	})
+}
+
+func TestServiceListFail(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+	mp.server.Close()
+
+	results := testListResource(t, ServiceListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+	}, false, 0)
+	assert.Len(t, results, 1)
+	assert.True(t, results[0].Diagnostics.HasError())
+}
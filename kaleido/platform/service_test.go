@@ -24,6 +24,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 
 	_ "embed"
@@ -109,6 +110,7 @@ func TestService1(t *testing.T) {
 			"GET /api/v1/environments/{env}/services/{service}",
 			"GET /api/v1/environments/{env}/services/{service}",
 			"GET /api/v1/environments/{env}/services/{service}",
+			"GET /api/v1/environments/{env}/services/{service}",
 			"DELETE /api/v1/environments/{env}/services/{service}",
 			"GET /api/v1/environments/{env}/services/{service}",
 		})
@@ -237,6 +239,83 @@ func TestService1(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      service1Resource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// hostnames, file_sets and cred_sets are not read back from the service
+				ImportStateVerifyIgnore: []string{"hostnames", "file_sets", "cred_sets"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[service1Resource].Primary.Attributes["id"]
+					return fmt.Sprintf("env1/%s", id), nil
+				},
+			},
+		},
+	})
+}
+
+var serviceWriteOnlyCredSets = `
+resource "kaleido_platform_service" "service_wo" {
+    environment = "env1"
+	runtime = "runtime1"
+    type = "besu"
+    name = "service_wo"
+    config_json = jsonencode({})
+	cred_sets_wo = {
+		"auth1": {
+			"type": "basic_auth"
+			"basic_auth": {
+				"username": "user1"
+				"password": "pass1"
+			}
+		}
+	}
+	cred_sets_wo_version = 1
+	wait_for_ready = false
+}
+`
+
+func TestServiceWriteOnlyCredSets(t *testing.T) {
+
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"POST /api/v1/environments/{env}/services",
+			"GET /api/v1/environments/{env}/services/{service}",
+			"DELETE /api/v1/environments/{env}/services/{service}",
+			"GET /api/v1/environments/{env}/services/{service}",
+		})
+		mp.server.Close()
+	}()
+
+	serviceResource := "kaleido_platform_service.service_wo"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + serviceWriteOnlyCredSets,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(serviceResource, "id"),
+					resource.TestCheckNoResourceAttr(serviceResource, "cred_sets_wo.%"),
+					resource.TestCheckResourceAttr(serviceResource, "cred_sets_wo_version", "1"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources[serviceResource].Primary.Attributes["id"]
+						svc := mp.services[fmt.Sprintf("env1/%s", id)]
+						assert.NotNil(t, svc)
+						assert.Equal(t, &CredSetAPI{
+							Name: "auth1",
+							Type: "basic_auth",
+							BasicAuth: &CredSetBasicAuthAPI{
+								Username: "user1",
+								Password: "pass1",
+							},
+						}, svc.Credsets["auth1"])
+						return nil
+					},
+				),
+			},
 		},
 	})
 }
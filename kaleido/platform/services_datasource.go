@@ -0,0 +1,171 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ServicesDatasourceModel struct {
+	Environment types.String                  `tfsdk:"environment"`
+	Type        types.String                  `tfsdk:"type"`
+	StackID     types.String                  `tfsdk:"stack_id"`
+	NamePrefix  types.String                  `tfsdk:"name_prefix"`
+	Status      types.String                  `tfsdk:"status"`
+	Services    []ServicesDatasourceItemModel `tfsdk:"services"`
+}
+
+type ServicesDatasourceItemModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	Type                types.String  `tfsdk:"type"`
+	Runtime             types.String  `tfsdk:"runtime"`
+	StackID             types.String  `tfsdk:"stack_id"`
+	EnvironmentMemberID types.String  `tfsdk:"environment_member_id"`
+	Status              types.String  `tfsdk:"status"`
+	ConfigJSON          jsonStringVal `tfsdk:"config_json"`
+	Endpoints           types.Map     `tfsdk:"endpoints"`
+}
+
+func (api *ServiceAPIModel) toListItemData(diagnostics *diag.Diagnostics) ServicesDatasourceItemModel {
+	return ServicesDatasourceItemModel{
+		ID:                  types.StringValue(api.ID),
+		Name:                types.StringValue(api.Name),
+		Type:                types.StringValue(api.Type),
+		Runtime:             types.StringValue(api.Runtime.ID),
+		StackID:             types.StringValue(api.StackID),
+		EnvironmentMemberID: types.StringValue(api.EnvironmentMemberID),
+		Status:              types.StringValue(api.Status),
+		ConfigJSON:          configJSONFromAPI(jsonStringNull(), api.Config, diagnostics),
+		Endpoints:           api.endpointsToData(diagnostics),
+	}
+}
+
+func ServicesDatasourceModelFactory() datasource.DataSource {
+	return &servicesDatasource{}
+}
+
+type servicesDatasource struct {
+	commonDataSource
+}
+
+func (r *servicesDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_services"
+}
+
+func (r *servicesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the services in an environment, optionally filtered by type, stack, name prefix or status.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"type": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services of this type",
+			},
+			"stack_id": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services in this stack",
+			},
+			"name_prefix": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services with a name starting with this prefix",
+			},
+			"status": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services with this status, such as `ready`",
+			},
+			"services": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"runtime": &schema.StringAttribute{
+							Computed: true,
+						},
+						"stack_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"environment_member_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"status": &schema.StringAttribute{
+							Computed: true,
+						},
+						"config_json": &schema.StringAttribute{
+							Computed:   true,
+							CustomType: jsonStringType{stripNulls: true},
+						},
+						"endpoints": &schema.MapNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": &schema.StringAttribute{
+										Computed: true,
+									},
+									"urls": &schema.ListAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *servicesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServicesDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := url.Values{}
+	addListFilter(filters, "type", data.Type)
+	addListFilter(filters, "stackId", data.StackID)
+	addListFilter(filters, "status", data.Status)
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/services", data.Environment.ValueString())
+	services, ok := listAll[ServiceAPIModel](ctx, &r.commonDataSource, collectionPath, filters, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	services = filterByNamePrefix(services, data.NamePrefix, func(v *ServiceAPIModel) string { return v.Name })
+
+	data.Services = make([]ServicesDatasourceItemModel, len(services))
+	for i := range services {
+		data.Services[i] = services[i].toListItemData(&resp.Diagnostics)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,65 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var servicesDataStep1 = `
+data "kaleido_platform_services" "besu_nodes" {
+    environment = "env1"
+    type = "BesuNode"
+    stack_id = "stack1"
+    status = "ready"
+}
+`
+
+func TestServicesData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.services["env1/svc1"] = &ServiceAPIModel{ID: "svc1", Name: "node1", Type: "BesuNode", StackID: "stack1", Status: "ready",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt1"}}
+	mp.services["env1/svc2"] = &ServiceAPIModel{ID: "svc2", Name: "node2", Type: "BesuNode", StackID: "stack1", Status: "ready",
+		Runtime: ServiceAPIRuntimeRef{ID: "rt2"}}
+	mp.services["env1/svc3"] = &ServiceAPIModel{ID: "svc3", Name: "node3", Type: "BesuNode", StackID: "stack1", Status: "pending"}
+	mp.services["env1/svc4"] = &ServiceAPIModel{ID: "svc4", Name: "signer1", Type: "EVMGateway", StackID: "stack1", Status: "ready"}
+	mp.services["env2/svc5"] = &ServiceAPIModel{ID: "svc5", Name: "node1", Type: "BesuNode", StackID: "stack1", Status: "ready"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/services",
+			"GET /api/v1/environments/{env}/services",
+			"GET /api/v1/environments/{env}/services",
+		})
+		mp.server.Close()
+	}()
+
+	servicesData := "data.kaleido_platform_services.besu_nodes"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + servicesDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(servicesData, "services.#", "2"),
+					resource.TestCheckResourceAttr(servicesData, "services.0.id", "svc1"),
+					resource.TestCheckResourceAttr(servicesData, "services.0.runtime", "rt1"),
+					resource.TestCheckResourceAttr(servicesData, "services.1.id", "svc2"),
+				),
+			},
+		},
+	})
+}
@@ -176,3 +176,7 @@ func (r *stackAccessResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *stackAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "stack_id", "id")
+}
@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,13 +32,14 @@ import (
 
 // stacks interface implementations for the resource and API
 type StacksResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Environment         types.String `tfsdk:"environment"`
-	EnvironmentMemberID types.String `tfsdk:"environment_member_id"`
-	Name                types.String `tfsdk:"name"`
-	Type                types.String `tfsdk:"type"`
-	SubType             types.String `tfsdk:"sub_type"`
-	NetworkId           types.String `tfsdk:"network_id"`
+	ID                  types.String   `tfsdk:"id"`
+	Environment         types.String   `tfsdk:"environment"`
+	EnvironmentMemberID types.String   `tfsdk:"environment_member_id"`
+	Name                types.String   `tfsdk:"name"`
+	Type                types.String   `tfsdk:"type"`
+	SubType             types.String   `tfsdk:"sub_type"`
+	NetworkId           types.String   `tfsdk:"network_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type StacksAPIModel struct {
@@ -63,9 +65,12 @@ func (r *stacksResource) Metadata(_ context.Context, _ resource.MetadataRequest,
 	resp.TypeName = "kaleido_platform_stack"
 }
 
-func (r *stacksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *stacksResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A stack is a collection of services within Digital Assets, Web3 Middleware or Chain Infrastructure. \n Stacks provide guidance around the optimal relationships and architecture of services for specific use cases, business units or chain connections. \n Every resource created within a stack is created in the context of an environment.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 		Attributes: map[string]schema.Attribute{
 			"id": &schema.StringAttribute{
 				Computed:      true,
@@ -152,6 +157,13 @@ func (r *stacksResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	var data StacksResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var api StacksAPIModel
 	data.toAPI(&api)
@@ -169,6 +181,13 @@ func (r *stacksResource) Update(ctx context.Context, req resource.UpdateRequest,
 	var data StacksResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Read full current object
 	var api StacksAPIModel
@@ -189,6 +208,13 @@ func (r *stacksResource) Update(ctx context.Context, req resource.UpdateRequest,
 func (r *stacksResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data StacksResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	var api StacksAPIModel
 	api.ID = data.ID.ValueString()
@@ -208,8 +234,19 @@ func (r *stacksResource) Read(ctx context.Context, req resource.ReadRequest, res
 func (r *stacksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data StacksResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data), nil, nil, &resp.Diagnostics, Allow404())
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *stacksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "id")
+}
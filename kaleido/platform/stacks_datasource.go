@@ -0,0 +1,131 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StacksDatasourceModel struct {
+	Environment types.String                `tfsdk:"environment"`
+	Type        types.String                `tfsdk:"type"`
+	NamePrefix  types.String                `tfsdk:"name_prefix"`
+	Stacks      []StacksDatasourceItemModel `tfsdk:"stacks"`
+}
+
+type StacksDatasourceItemModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	SubType             types.String `tfsdk:"sub_type"`
+	NetworkId           types.String `tfsdk:"network_id"`
+	EnvironmentMemberID types.String `tfsdk:"environment_member_id"`
+}
+
+func (api *StacksAPIModel) toListItemData() StacksDatasourceItemModel {
+	return StacksDatasourceItemModel{
+		ID:                  types.StringValue(api.ID),
+		Name:                types.StringValue(api.Name),
+		Type:                types.StringValue(api.Type),
+		SubType:             types.StringValue(api.SubType),
+		NetworkId:           types.StringValue(api.NetworkId),
+		EnvironmentMemberID: types.StringValue(api.EnvironmentMemberID),
+	}
+}
+
+func StacksDatasourceModelFactory() datasource.DataSource {
+	return &stacksDatasource{}
+}
+
+type stacksDatasource struct {
+	commonDataSource
+}
+
+func (r *stacksDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_stacks"
+}
+
+func (r *stacksDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the stacks in an environment, optionally filtered by type or name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"type": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list stacks of this type, such as `chain_infrastructure`",
+			},
+			"name_prefix": &schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list stacks with a name starting with this prefix",
+			},
+			"stacks": &schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"name": &schema.StringAttribute{
+							Computed: true,
+						},
+						"type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"sub_type": &schema.StringAttribute{
+							Computed: true,
+						},
+						"network_id": &schema.StringAttribute{
+							Computed: true,
+						},
+						"environment_member_id": &schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *stacksDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StacksDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := url.Values{}
+	addListFilter(filters, "type", data.Type)
+	collectionPath := fmt.Sprintf("/api/v1/environments/%s/stacks", data.Environment.ValueString())
+	stacks, ok := listAll[StacksAPIModel](ctx, &r.commonDataSource, collectionPath, filters, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	stacks = filterByNamePrefix(stacks, data.NamePrefix, func(v *StacksAPIModel) string { return v.Name })
+
+	data.Stacks = make([]StacksDatasourceItemModel, len(stacks))
+	for i := range stacks {
+		data.Stacks[i] = stacks[i].toListItemData()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var stacksDataStep1 = `
+data "kaleido_platform_stacks" "stacks" {
+    environment = "env1"
+}
+`
+
+func TestStacksData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.stacks["env1/stack1"] = &StacksAPIModel{ID: "stack1", Name: "chain", Type: "chain_infrastructure", SubType: "BesuStack", NetworkId: "net1"}
+	mp.stacks["env1/stack2"] = &StacksAPIModel{ID: "stack2", Name: "assets", Type: "digital_assets"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/environments/{env}/stacks",
+			"GET /api/v1/environments/{env}/stacks",
+			"GET /api/v1/environments/{env}/stacks",
+		})
+		mp.server.Close()
+	}()
+
+	stacksData := "data.kaleido_platform_stacks.stacks"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + stacksDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(stacksData, "stacks.#", "2"),
+					resource.TestCheckResourceAttr(stacksData, "stacks.0.network_id", "net1"),
+					resource.TestCheckResourceAttr(stacksData, "stacks.1.type", "digital_assets"),
+				),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listStacks(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.stacks, mux.Vars(req)["env"]+"/")
+}
@@ -0,0 +1,120 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserDatasourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Email   types.String `tfsdk:"email"`
+	Sub     types.String `tfsdk:"sub"`
+	Name    types.String `tfsdk:"name"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+	Account types.String `tfsdk:"account"`
+}
+
+func (api *UserAPIModel) toDatasourceData(data *UserDatasourceModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Email = types.StringValue(api.Email)
+	data.Sub = types.StringValue(api.Sub)
+	data.Name = types.StringValue(api.Name)
+	data.IsAdmin = types.BoolValue(api.IsAdmin != nil && *api.IsAdmin)
+	data.Account = types.StringValue(api.Account)
+}
+
+func UserDatasourceModelFactory() datasource.DataSource {
+	return &userDatasource{}
+}
+
+type userDatasource struct {
+	commonDataSource
+}
+
+func (r *userDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_user"
+}
+
+func (r *userDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing user in the account by email, OIDC subject or ID, such as a user provisioned by SSO.",
+		Attributes: map[string]schema.Attribute{
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User ID. Exactly one of `id`, `email` or `sub` must be set",
+			},
+			"email": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address of the user. Exactly one of `id`, `email` or `sub` must be set",
+			},
+			"sub": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "OIDC subject of the user. Exactly one of `id`, `email` or `sub` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the user",
+			},
+			"is_admin": &schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user is an account administrator",
+			},
+			"account": &schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the account the user belongs to",
+			},
+		},
+	}
+}
+
+func (r *userDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("email"), path.MatchRoot("sub")),
+	}
+}
+
+func (r *userDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api UserAPIModel
+	var ok bool
+	switch {
+	case data.Email.ValueString() != "":
+		ok = lookupByField(ctx, &r.commonDataSource, "/api/v1/users", "user", "email", "email", data.Email.ValueString(), &api, &resp.Diagnostics)
+	case data.Sub.ValueString() != "":
+		ok = lookupByField(ctx, &r.commonDataSource, "/api/v1/users", "user", "sub", "sub", data.Sub.ValueString(), &api, &resp.Diagnostics)
+	default:
+		ok = lookupByNameOrID(ctx, &r.commonDataSource, "/api/v1/users", "user", data.ID, types.StringNull(), &api, &resp.Diagnostics)
+	}
+	if !ok {
+		return
+	}
+
+	api.toDatasourceData(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,103 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var userDataStep1 = `
+data "kaleido_platform_user" "user1" {
+    email = "user1@example.com"
+}
+`
+
+var userDataStep2 = `
+data "kaleido_platform_user" "user1" {
+    sub = "unknown-sub"
+}
+`
+
+func TestUserData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	isAdmin := true
+	mp.users["user1"] = &UserAPIModel{ID: "user1", Name: "User One", Email: "user1@example.com", Sub: "sso|1234", Account: "acct1", IsAdmin: &isAdmin}
+	mp.users["user2"] = &UserAPIModel{ID: "user2", Name: "User Two", Email: "user2@example.com", Sub: "sso|5678", Account: "acct1"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/users",
+			"GET /api/v1/users",
+			"GET /api/v1/users",
+		})
+		mp.server.Close()
+	}()
+
+	userData := "data.kaleido_platform_user.user1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + userDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userData, "id", "user1"),
+					resource.TestCheckResourceAttr(userData, "name", "User One"),
+					resource.TestCheckResourceAttr(userData, "sub", "sso|1234"),
+					resource.TestCheckResourceAttr(userData, "is_admin", "true"),
+					resource.TestCheckResourceAttr(userData, "account", "acct1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataNotFound(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.users["user1"] = &UserAPIModel{ID: "user1", Name: "User One", Email: "user1@example.com", Sub: "sso|1234"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /api/v1/users",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + userDataStep2,
+				ExpectError: regexp.MustCompile(`No user with sub 'unknown-sub' was found`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listUsers(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.users, "")
+}
+
+func (mp *mockPlatform) getUser(res http.ResponseWriter, req *http.Request) {
+	obj := mp.users[mux.Vars(req)["user"]]
+	if obj == nil {
+		mp.respond(res, nil, 404)
+	} else {
+		mp.respond(res, obj, 200)
+	}
+}
@@ -43,9 +43,9 @@ type WFEStreamResourceModel struct {
 	Service            types.String                     `tfsdk:"service"`
 	Transform          *WFEStreamTransformResourceModel `tfsdk:"transform"`
 	EventSourceType    types.String                     `tfsdk:"event_source_type"`
-	EventSourceJSON    types.String                     `tfsdk:"event_source_json"`
+	EventSourceJSON    jsonStringVal                    `tfsdk:"event_source_json"`
 	EventProcessorType types.String                     `tfsdk:"event_processor_type"`
-	EventProcessorJSON types.String                     `tfsdk:"event_processor_json"`
+	EventProcessorJSON jsonStringVal                    `tfsdk:"event_processor_json"`
 	Started            types.Bool                       `tfsdk:"started"`
 	Created            types.String                     `tfsdk:"created"`
 	Updated            types.String                     `tfsdk:"updated"`
@@ -137,6 +137,7 @@ func (r *wfe_streamResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"event_source_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Required:    true,
 				Description: "The event source configuration as JSON (the type-specific content nested under the event source type key).",
 			},
@@ -146,6 +147,7 @@ func (r *wfe_streamResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"event_processor_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Required:    true,
 				Description: "The event processor configuration as JSON (the type-specific content nested under the event processor type key).",
 			},
@@ -298,17 +300,17 @@ func (r *wfe_streamResource) toData(api *WFEStreamAPIModel, data *WFEStreamResou
 					diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Failed to marshal stream event source: %v", err))
 					return
 				}
-				data.EventSourceJSON = types.StringValue(string(contentBytes))
+				data.EventSourceJSON = newJSONString(string(contentBytes))
 			} else {
-				data.EventSourceJSON = types.StringNull()
+				data.EventSourceJSON = jsonStringNull()
 			}
 		} else {
 			data.EventSourceType = types.StringNull()
-			data.EventSourceJSON = types.StringNull()
+			data.EventSourceJSON = jsonStringNull()
 		}
 	} else {
 		data.EventSourceType = types.StringNull()
-		data.EventSourceJSON = types.StringNull()
+		data.EventSourceJSON = jsonStringNull()
 	}
 
 	// EventProcessor: extract type and type-specific content from nested structure
@@ -325,17 +327,17 @@ func (r *wfe_streamResource) toData(api *WFEStreamAPIModel, data *WFEStreamResou
 					diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Failed to marshal stream event processor: %v", err))
 					return
 				}
-				data.EventProcessorJSON = types.StringValue(string(contentBytes))
+				data.EventProcessorJSON = newJSONString(string(contentBytes))
 			} else {
-				data.EventProcessorJSON = types.StringNull()
+				data.EventProcessorJSON = jsonStringNull()
 			}
 		} else {
 			data.EventProcessorType = types.StringNull()
-			data.EventProcessorJSON = types.StringNull()
+			data.EventProcessorJSON = jsonStringNull()
 		}
 	} else {
 		data.EventProcessorType = types.StringNull()
-		data.EventProcessorJSON = types.StringNull()
+		data.EventProcessorJSON = jsonStringNull()
 	}
 
 	if api.Started == nil {
@@ -436,3 +438,7 @@ func (r *wfe_streamResource) Delete(ctx context.Context, req resource.DeleteRequ
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data, data.ID.ValueString()), nil, nil, &resp.Diagnostics, Allow404())
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *wfe_streamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
@@ -31,21 +31,21 @@ import (
 
 // WFEStreamFactoryResourceModel is the Terraform state model for a WFE stream factory.
 type WFEStreamFactoryResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	Environment          types.String `tfsdk:"environment"`
-	Service              types.String `tfsdk:"service"`
-	Name                 types.String `tfsdk:"name"`
-	Description          types.String `tfsdk:"description"`
-	ConfigType           types.String `tfsdk:"config_type"`
-	UniquenessPrefix     types.String `tfsdk:"uniqueness_prefix"`
-	EventSourceType      types.String `tfsdk:"event_source_type"`
-	EventSourceJSON      types.String `tfsdk:"event_source_json"`
-	ConstantsJSON        types.String `tfsdk:"constants_json"`
-	ParametersSchemaJSON types.String `tfsdk:"parameters_schema_json"`
-	APIURL               types.String `tfsdk:"api_url"`
-	OpenAPIURL           types.String `tfsdk:"openapi_url"`
-	Created              types.String `tfsdk:"created"`
-	Updated              types.String `tfsdk:"updated"`
+	ID                   types.String  `tfsdk:"id"`
+	Environment          types.String  `tfsdk:"environment"`
+	Service              types.String  `tfsdk:"service"`
+	Name                 types.String  `tfsdk:"name"`
+	Description          types.String  `tfsdk:"description"`
+	ConfigType           types.String  `tfsdk:"config_type"`
+	UniquenessPrefix     types.String  `tfsdk:"uniqueness_prefix"`
+	EventSourceType      types.String  `tfsdk:"event_source_type"`
+	EventSourceJSON      jsonStringVal `tfsdk:"event_source_json"`
+	ConstantsJSON        jsonStringVal `tfsdk:"constants_json"`
+	ParametersSchemaJSON jsonStringVal `tfsdk:"parameters_schema_json"`
+	APIURL               types.String  `tfsdk:"api_url"`
+	OpenAPIURL           types.String  `tfsdk:"openapi_url"`
+	Created              types.String  `tfsdk:"created"`
+	Updated              types.String  `tfsdk:"updated"`
 }
 
 // WFEStreamFactoryURLsAPI mirrors the APIURLs JSON from workflow-engine engtypes.
@@ -124,14 +124,17 @@ func (r *wfe_streamFactoryResource) Schema(_ context.Context, _ resource.SchemaR
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"event_source_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Required:    true,
 				Description: "The event source configuration for the stream factory. JSON for the handler or transaction payload matching `event_source_type` (the provider sets the `type` field and nests this object under the corresponding key).",
 			},
 			"constants_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "Constants passed into the configMapping JSONata evaluation",
 			},
 			"parameters_schema_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "OpenAPI compliant JSON Schema for the input parameters passed to the stream factory",
 			},
@@ -233,17 +236,17 @@ func (r *wfe_streamFactoryResource) toData(api *WFEStreamFactoryAPIModel, data *
 					diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Failed to marshal stream factory event source: %v", err))
 					return
 				}
-				data.EventSourceJSON = types.StringValue(string(contentBytes))
+				data.EventSourceJSON = newJSONString(string(contentBytes))
 			} else {
-				data.EventSourceJSON = types.StringNull()
+				data.EventSourceJSON = jsonStringNull()
 			}
 		} else {
 			data.EventSourceType = types.StringNull()
-			data.EventSourceJSON = types.StringNull()
+			data.EventSourceJSON = jsonStringNull()
 		}
 	} else {
 		data.EventSourceType = types.StringNull()
-		data.EventSourceJSON = types.StringNull()
+		data.EventSourceJSON = jsonStringNull()
 	}
 
 	// Constants: marshal back to JSON string
@@ -253,10 +256,10 @@ func (r *wfe_streamFactoryResource) toData(api *WFEStreamFactoryAPIModel, data *
 			diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Failed to marshal stream factory constants: %v", err))
 			return
 		}
-		data.ConstantsJSON = types.StringValue(string(constantsBytes))
+		data.ConstantsJSON = newJSONString(string(constantsBytes))
 	} else if !data.ConstantsJSON.IsNull() {
 		// Preserve null if not set by API
-		data.ConstantsJSON = types.StringNull()
+		data.ConstantsJSON = jsonStringNull()
 	}
 
 	// ParametersSchema: marshal back to JSON string
@@ -266,9 +269,9 @@ func (r *wfe_streamFactoryResource) toData(api *WFEStreamFactoryAPIModel, data *
 			diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Failed to marshal stream factory parameters schema: %v", err))
 			return
 		}
-		data.ParametersSchemaJSON = types.StringValue(string(schemaBytes))
+		data.ParametersSchemaJSON = newJSONString(string(schemaBytes))
 	} else if !data.ParametersSchemaJSON.IsNull() {
-		data.ParametersSchemaJSON = types.StringNull()
+		data.ParametersSchemaJSON = jsonStringNull()
 	}
 
 	// URLs: computed by the server
@@ -382,3 +385,7 @@ func (r *wfe_streamFactoryResource) Delete(ctx context.Context, req resource.Del
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data, data.ID.ValueString()), nil, nil, &resp.Diagnostics, Allow404())
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *wfe_streamFactoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
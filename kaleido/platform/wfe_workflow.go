@@ -32,18 +32,18 @@ import (
 )
 
 type WFEWorkflowResourceModel struct {
-	ID                        types.String `tfsdk:"id"`
-	Name                      types.String `tfsdk:"name"`
-	Description               types.String `tfsdk:"description"`
-	Environment               types.String `tfsdk:"environment"`
-	Service                   types.String `tfsdk:"service"`
-	FlowYAML                  types.String `tfsdk:"flow_yaml"` // yaml string containing workflow definition
-	AppliedVersion            types.String `tfsdk:"applied_version"`
-	Created                   types.String `tfsdk:"created"`
-	Updated                   types.String `tfsdk:"updated"`
-	HandlerBindingsJSON       types.String `tfsdk:"handler_bindings_json"`
-	SubflowBindingsJSON       types.String `tfsdk:"subflow_bindings_json"`
-	ConfigProfileBindingsJSON types.String `tfsdk:"config_profile_bindings_json"`
+	ID                        types.String  `tfsdk:"id"`
+	Name                      types.String  `tfsdk:"name"`
+	Description               types.String  `tfsdk:"description"`
+	Environment               types.String  `tfsdk:"environment"`
+	Service                   types.String  `tfsdk:"service"`
+	FlowYAML                  yamlStringVal `tfsdk:"flow_yaml"` // yaml string containing workflow definition
+	AppliedVersion            types.String  `tfsdk:"applied_version"`
+	Created                   types.String  `tfsdk:"created"`
+	Updated                   types.String  `tfsdk:"updated"`
+	HandlerBindingsJSON       jsonStringVal `tfsdk:"handler_bindings_json"`
+	SubflowBindingsJSON       jsonStringVal `tfsdk:"subflow_bindings_json"`
+	ConfigProfileBindingsJSON jsonStringVal `tfsdk:"config_profile_bindings_json"`
 }
 
 type WFEWorkflowAPIModel struct {
@@ -79,6 +79,9 @@ type wfe_workflowResource struct {
 	commonResource
 }
 
+// wfeWorkflowIdentity identifies a workflow by the attributes of its import ID
+var wfeWorkflowIdentity = resourceIdentity{"environment", "service", "id"}
+
 func (r *wfe_workflowResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "kaleido_platform_wfe_workflow"
 }
@@ -111,8 +114,9 @@ func (r *wfe_workflowResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Description of the workflow",
 			},
 			"flow_yaml": &schema.StringAttribute{
+				CustomType:  yamlStringType{},
 				Required:    true,
-				Description: "The workflow definition as YAML.  This includes stages, events, operations and subflows but does not include handler bindings or subflow bindings",
+				Description: "The workflow definition as YAML.  This includes stages, events, operations and subflows but does not include handler bindings or subflow bindings. Changes to comments, key order or quoting style alone do not create a new version",
 			},
 			"applied_version": &schema.StringAttribute{
 				Computed:    true,
@@ -127,14 +131,17 @@ func (r *wfe_workflowResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Last update timestamp",
 			},
 			"handler_bindings_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "The workflow handler bindings as JSON",
 			},
 			"subflow_bindings_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "The workflow subflow bindings as JSON",
 			},
 			"config_profile_bindings_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "The workflow config profile bindings as JSON",
 			},
@@ -275,6 +282,7 @@ func (r *wfe_workflowResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	r.toData(&updatedAPI, &data, &resp.Diagnostics)
+	wfeWorkflowIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -296,13 +304,16 @@ func (r *wfe_workflowResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	r.toData(&api, &data, &resp.Diagnostics)
+	wfeWorkflowIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *wfe_workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data WFEWorkflowResourceModel
+	var priorFlowYAML yamlStringVal
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("flow_yaml"), &priorFlowYAML)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -317,16 +328,18 @@ func (r *wfe_workflowResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	// Create a new version with updated definition
-	var versionAPI WFEWorkflowVersionAPIModel
-	ok = r.toVersionAPI(&data, &versionAPI, &resp.Diagnostics)
-	if !ok {
-		return
-	}
+	// Create a new version with updated definition, only if the definition has actually changed
+	if !priorFlowYAML.SemanticallyEqual(data.FlowYAML) {
+		var versionAPI WFEWorkflowVersionAPIModel
+		ok = r.toVersionAPI(&data, &versionAPI, &resp.Diagnostics)
+		if !ok {
+			return
+		}
 
-	ok, _ = r.apiRequest(ctx, http.MethodPost, r.apiWorkflowVersionPath(&data, api.Name), data.FlowYAML.ValueString(), nil, &resp.Diagnostics, YAMLBody())
-	if !ok {
-		return
+		ok, _ = r.apiRequest(ctx, http.MethodPost, r.apiWorkflowVersionPath(&data, api.Name), data.FlowYAML.ValueString(), nil, &resp.Diagnostics, YAMLBody())
+		if !ok {
+			return
+		}
 	}
 
 	// Fetch the updated workflow with the current version
@@ -338,6 +351,7 @@ func (r *wfe_workflowResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	r.toData(&updatedAPI, &data, &resp.Diagnostics)
+	wfeWorkflowIdentity.set(ctx, resp.Identity, &resp.Diagnostics, data.Environment, data.Service, data.ID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -351,3 +365,11 @@ func (r *wfe_workflowResource) Delete(ctx context.Context, req resource.DeleteRe
 	_, _ = r.apiRequest(ctx, http.MethodDelete, r.apiPath(&data, data.ID.ValueString()), nil, nil, &resp.Diagnostics, Allow404())
 	r.waitForRemoval(ctx, r.apiPath(&data, data.ID.ValueString()), &resp.Diagnostics)
 }
+
+func (r *wfe_workflowResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = wfeWorkflowIdentity.schema()
+}
+
+func (r *wfe_workflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, wfeWorkflowIdentity...)
+}
@@ -0,0 +1,76 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WFEWorkflowListModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Service     types.String `tfsdk:"service"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+}
+
+func WFEWorkflowListResourceFactory() list.ListResource {
+	return &wfe_workflowResource{}
+}
+
+func (r *wfe_workflowResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "List the workflows in a Workflow Engine service, optionally filtered by name prefix.",
+		Attributes: map[string]listschema.Attribute{
+			"environment": listschema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": listschema.StringAttribute{
+				Required:    true,
+				Description: "Workflow Engine Service ID",
+			},
+			"name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows with a name starting with this prefix",
+			},
+		},
+	}
+}
+
+func (r *wfe_workflowResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config WFEWorkflowListModel
+	diagnostics := req.Config.Get(ctx, &config)
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/workflows", config.Environment.ValueString(), config.Service.ValueString())
+	workflows, ok := listAll[WFEWorkflowAPIModel](ctx, r, collectionPath, url.Values{}, &diagnostics)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	workflows = filterByNamePrefix(workflows, config.NamePrefix, func(v *WFEWorkflowAPIModel) string { return v.Name })
+
+	stream.Results = listResults(ctx, req, wfeWorkflowIdentity, []types.String{config.Environment, config.Service}, workflows, func(api *WFEWorkflowAPIModel, data *WFEWorkflowResourceModel, diagnostics *diag.Diagnostics) {
+		r.toData(api, data, diagnostics)
+	})
+}
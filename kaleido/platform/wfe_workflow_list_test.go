@@ -0,0 +1,79 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kaleido-io/terraform-provider-kaleido/kaleido/kaleidobase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWFEWorkflowList(t *testing.T) {
+	mp := startMockPlatformServer(t)
+	defer mp.server.Close()
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, wf := range []*WFEWorkflowAPIModel{
+		{ID: "wf1_id", Name: "transfer-flow", Description: "Token transfers", CurrentVersion: "v3", Created: &created, Updated: &created},
+		{ID: "wf2_id", Name: "transfer-approval", CurrentVersion: "v1"},
+		{ID: "wf3_id", Name: "mint-flow", CurrentVersion: "v1"},
+	} {
+		mp.wfeWorkflows[wf.Name] = wf
+		mp.wfeWorkflows[wf.ID] = wf
+	}
+	pd := &kaleidobase.ProviderData{
+		Platform: resty.New().SetBaseURL(mp.server.URL),
+	}
+
+	results := testListResource(t, WFEWorkflowListResourceFactory(), pd, map[string]tftypes.Value{
+		"environment": tftypes.NewValue(tftypes.String, "env1"),
+		"service":     tftypes.NewValue(tftypes.String, "service1"),
+		"name_prefix": tftypes.NewValue(tftypes.String, "transfer-"),
+	}, true, 0)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "transfer-flow", results[0].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "id": "wf1_id"}, testListResultAttrs(t, results[0].Identity, "environment", "service", "id"))
+	assert.Equal(t, map[string]string{
+		"environment":     "env1",
+		"service":         "service1",
+		"id":              "wf1_id",
+		"name":            "transfer-flow",
+		"description":     "Token transfers",
+		"applied_version": "v3",
+		"created":         "2026-01-02T03:04:05Z",
+	}, testListResultAttrs(t, results[0].Resource, "environment", "service", "id", "name", "description", "applied_version", "created"))
+	assert.Equal(t, "transfer-approval", results[1].DisplayName)
+	assert.Equal(t, map[string]string{"environment": "env1", "service": "service1", "id": "wf2_id"}, testListResultAttrs(t, results[1].Identity, "environment", "service", "id"))
+	mp.checkClearCalls([]string{
+		"GET /endpoint/{env}/{service}/rest/api/v1/workflows",
+	})
+}
+
+func (mp *mockPlatform) listWFEWorkflows(res http.ResponseWriter, req *http.Request) {
+	// Workflows are stored under both their name and ID, so only list each one under its ID
+	workflows := map[string]*WFEWorkflowAPIModel{}
+	for key, wf := range mp.wfeWorkflows {
+		if key == wf.ID {
+			workflows[key] = wf
+		}
+	}
+	respondList(mp, res, req, workflows, "")
+}
@@ -0,0 +1,160 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WMSAccountDatasourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Environment    types.String `tfsdk:"environment"`
+	Service        types.String `tfsdk:"service"`
+	Identifier     types.String `tfsdk:"identifier"`
+	Asset          types.String `tfsdk:"asset"`
+	Wallet         types.String `tfsdk:"wallet"`
+	IdentifierType types.String `tfsdk:"identifier_type"`
+}
+
+func (api *WMSAccountAPIModel) toDatasourceData(data *WMSAccountDatasourceModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Identifier = types.StringValue(api.Identifier)
+	data.Asset = types.StringValue(api.AssetID)
+	data.Wallet = types.StringValue(api.WalletID)
+	data.IdentifierType = types.StringValue(api.IdentifierType)
+}
+
+func WMSAccountDatasourceModelFactory() datasource.DataSource {
+	return &wms_accountDatasource{}
+}
+
+type wms_accountDatasource struct {
+	commonDataSource
+}
+
+func (r *wms_accountDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_wms_account"
+}
+
+func (r *wms_accountDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing account in a wallet manager by identifier or ID. An account connects a wallet to an asset.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Wallet Management Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Account ID. Exactly one of `id` or `identifier` must be set",
+			},
+			"identifier": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Account identifier, such as an ethereum address. Exactly one of `id` or `identifier` must be set",
+			},
+			"asset": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Asset ID. Can be set to narrow a lookup by `identifier` when the identifier holds more than one asset",
+			},
+			"wallet": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wallet ID. Can be set to narrow a lookup by `identifier` when the identifier is in more than one wallet",
+			},
+			"identifier_type": &schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the account identifier, such as `eth_address`",
+			},
+		},
+	}
+}
+
+func (r *wms_accountDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("identifier")),
+		datasourcevalidator.Conflicting(path.MatchRoot("id"), path.MatchRoot("asset")),
+		datasourcevalidator.Conflicting(path.MatchRoot("id"), path.MatchRoot("wallet")),
+	}
+}
+
+func (r *wms_accountDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WMSAccountDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api WMSAccountAPIModel
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/accounts", data.Environment.ValueString(), data.Service.ValueString())
+	if data.ID.ValueString() != "" {
+		if ok, _ := r.apiRequest(ctx, http.MethodGet, collectionPath+"/"+url.PathEscape(data.ID.ValueString()), nil, &api, &resp.Diagnostics); !ok {
+			return
+		}
+	} else if !r.lookupByIdentifier(ctx, collectionPath, &data, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// lookupByIdentifier finds the single account with the configured identifier. The same identifier is
+// used by an account for each asset the wallet holds, so the lookup can be narrowed by asset and wallet.
+func (r *wms_accountDatasource) lookupByIdentifier(ctx context.Context, collectionPath string, data *WMSAccountDatasourceModel, api *WMSAccountAPIModel, diagnostics *diag.Diagnostics) bool {
+	identifier := data.Identifier.ValueString()
+	filters := url.Values{}
+	addListFilter(filters, "identifier", data.Identifier)
+	addListFilter(filters, "assetId", data.Asset)
+	addListFilter(filters, "walletId", data.Wallet)
+	accounts, ok := listAll[WMSAccountAPIModel](ctx, &r.commonDataSource, collectionPath, filters, diagnostics)
+	if !ok {
+		return false
+	}
+	var matches []WMSAccountAPIModel
+	for _, account := range accounts {
+		if account.Identifier == identifier {
+			matches = append(matches, account)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		diagnostics.AddAttributeError(path.Root("identifier"), "account not found",
+			fmt.Sprintf("No account with identifier '%s' was found in %s", identifier, collectionPath))
+		return false
+	case 1:
+		*api = matches[0]
+		return true
+	default:
+		diagnostics.AddAttributeError(path.Root("identifier"), "multiple accounts found",
+			fmt.Sprintf("%d objects with identifier '%s' were found in %s. Set asset or wallet to narrow the lookup, or look up the account by id instead.", len(matches), identifier, collectionPath))
+		return false
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var wmsAccountDataStep1 = `
+data "kaleido_platform_wms_account" "account1" {
+    environment = "env1"
+    service = "service1"
+    identifier = "0x1234567890123456789012345678901234567890"
+    asset = "asset1"
+}
+`
+
+var wmsAccountDataStep2 = `
+data "kaleido_platform_wms_account" "account1" {
+    environment = "env1"
+    service = "service1"
+    identifier = "0x1234567890123456789012345678901234567890"
+}
+`
+
+func TestWMSAccountData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.wmsAccounts["env1/service1/account1"] = &WMSAccountAPIModel{
+		ID:             "account1",
+		AssetID:        "asset1",
+		WalletID:       "wallet1",
+		Identifier:     "0x1234567890123456789012345678901234567890",
+		IdentifierType: "eth_address",
+	}
+	mp.wmsAccounts["env1/service1/account2"] = &WMSAccountAPIModel{
+		ID:             "account2",
+		AssetID:        "asset2",
+		WalletID:       "wallet1",
+		Identifier:     "0x1234567890123456789012345678901234567890",
+		IdentifierType: "eth_address",
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/accounts",
+			"GET /endpoint/{env}/{service}/rest/api/v1/accounts",
+			"GET /endpoint/{env}/{service}/rest/api/v1/accounts",
+			"GET /endpoint/{env}/{service}/rest/api/v1/accounts",
+		})
+		mp.server.Close()
+	}()
+
+	accountData := "data.kaleido_platform_wms_account.account1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + wmsAccountDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(accountData, "id", "account1"),
+					resource.TestCheckResourceAttr(accountData, "wallet", "wallet1"),
+					resource.TestCheckResourceAttr(accountData, "identifier_type", "eth_address"),
+				),
+			},
+			{
+				Config:      providerConfig + wmsAccountDataStep2,
+				ExpectError: regexp.MustCompile(`2 objects with identifier '0x1234567890123456789012345678901234567890' were found`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listWMSAccounts(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.wmsAccounts, mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/")
+}
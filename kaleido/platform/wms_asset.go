@@ -29,17 +29,17 @@ import (
 )
 
 type WMSAssetResourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Environment           types.String `tfsdk:"environment"`
-	Service               types.String `tfsdk:"service"`
-	Name                  types.String `tfsdk:"name"`
-	Description           types.String `tfsdk:"description"`
-	Symbol                types.String `tfsdk:"symbol"`
-	ProtocolID            types.String `tfsdk:"protocol_id"`
-	AccountIdentifierType types.String `tfsdk:"account_identifier_type"`
-	Color                 types.String `tfsdk:"color"`
-	IconID                types.String `tfsdk:"icon_id"`
-	ConfigJSON            types.String `tfsdk:"config_json"`
+	ID                    types.String  `tfsdk:"id"`
+	Environment           types.String  `tfsdk:"environment"`
+	Service               types.String  `tfsdk:"service"`
+	Name                  types.String  `tfsdk:"name"`
+	Description           types.String  `tfsdk:"description"`
+	Symbol                types.String  `tfsdk:"symbol"`
+	ProtocolID            types.String  `tfsdk:"protocol_id"`
+	AccountIdentifierType types.String  `tfsdk:"account_identifier_type"`
+	Color                 types.String  `tfsdk:"color"`
+	IconID                types.String  `tfsdk:"icon_id"`
+	ConfigJSON            jsonStringVal `tfsdk:"config_json"`
 }
 
 type WMSAssetAPIModel struct {
@@ -127,6 +127,7 @@ func (r *wms_assetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The id of the icon associated with the asset, if one has been uploaded",
 			},
 			"config_json": &schema.StringAttribute{
+				CustomType:  jsonStringType{},
 				Optional:    true,
 				Description: "The asset configuration",
 			},
@@ -221,7 +222,7 @@ func (r *wms_assetResource) Create(ctx context.Context, req resource.CreateReque
 	var api WMSAssetAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
 	if ok {
-		ok, _ = r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), &api, &api, &resp.Diagnostics)
+		ok, _ = r.apiRequest(ctx, http.MethodPost, r.apiPath(&data), &api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	}
 	if ok {
 		ok = api.toData(&data, &resp.Diagnostics)
@@ -241,7 +242,7 @@ func (r *wms_assetResource) Update(ctx context.Context, req resource.UpdateReque
 	var api WMSAssetAPIModel
 	ok := data.toAPI(&api, &resp.Diagnostics)
 	if ok {
-		ok, _ = r.apiRequest(ctx, http.MethodPatch, r.apiPath(&data), &api, &api, &resp.Diagnostics)
+		ok, _ = r.apiRequest(ctx, http.MethodPatch, r.apiPath(&data), &api, &api, &resp.Diagnostics, ErrorFieldPath("config", path.Root("config_json")), ErrorAttributes(req.Plan.Schema))
 	}
 	if ok {
 		ok = api.toData(&data, &resp.Diagnostics)
@@ -281,3 +282,7 @@ func (r *wms_assetResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	r.waitForRemoval(ctx, r.apiPath(&data), &resp.Diagnostics)
 }
+
+func (r *wms_assetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromID(ctx, req, resp, "environment", "service", "id")
+}
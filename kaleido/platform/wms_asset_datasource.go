@@ -0,0 +1,146 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WMSAssetDatasourceModel struct {
+	ID                    types.String  `tfsdk:"id"`
+	Environment           types.String  `tfsdk:"environment"`
+	Service               types.String  `tfsdk:"service"`
+	Name                  types.String  `tfsdk:"name"`
+	Symbol                types.String  `tfsdk:"symbol"`
+	Description           types.String  `tfsdk:"description"`
+	ProtocolID            types.String  `tfsdk:"protocol_id"`
+	AccountIdentifierType types.String  `tfsdk:"account_identifier_type"`
+	Color                 types.String  `tfsdk:"color"`
+	IconID                types.String  `tfsdk:"icon_id"`
+	ConfigJSON            jsonStringVal `tfsdk:"config_json"`
+}
+
+func (api *WMSAssetAPIModel) toDatasourceData(data *WMSAssetDatasourceModel, diagnostics *diag.Diagnostics) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Symbol = types.StringValue(api.Symbol)
+	data.Description = types.StringValue(api.Description)
+	data.ProtocolID = types.StringValue(api.ProtocolID)
+	data.AccountIdentifierType = types.StringValue(api.AccountIdentifierType)
+	data.Color = types.StringValue(api.Color)
+	data.IconID = types.StringValue(api.IconID)
+	data.ConfigJSON = configJSONFromAPI(jsonStringNull(), api.Config, diagnostics)
+}
+
+func WMSAssetDatasourceModelFactory() datasource.DataSource {
+	return &wms_assetDatasource{}
+}
+
+type wms_assetDatasource struct {
+	commonDataSource
+}
+
+func (r *wms_assetDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_wms_asset"
+}
+
+func (r *wms_assetDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing asset in a wallet manager by symbol, name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Wallet Management Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Asset ID. Exactly one of `id`, `name` or `symbol` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Asset Name. Exactly one of `id`, `name` or `symbol` must be set",
+			},
+			"symbol": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Asset Symbol. Exactly one of `id`, `name` or `symbol` must be set",
+			},
+			"description": &schema.StringAttribute{
+				Computed: true,
+			},
+			"protocol_id": &schema.StringAttribute{
+				Computed:    true,
+				Description: "The protocol / blockchain identifier for this asset - such as its ethereum address",
+			},
+			"account_identifier_type": &schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of account identifier required for a wallet to hold this asset - such as an eth_address",
+			},
+			"color": &schema.StringAttribute{
+				Computed: true,
+			},
+			"icon_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"config_json": &schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsonStringType{},
+				Description: "The asset configuration",
+			},
+		},
+	}
+}
+
+func (r *wms_assetDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name"), path.MatchRoot("symbol")),
+	}
+}
+
+func (r *wms_assetDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WMSAssetDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api WMSAssetAPIModel
+	var ok bool
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/assets", data.Environment.ValueString(), data.Service.ValueString())
+	if data.Symbol.ValueString() != "" {
+		ok = lookupByField(ctx, &r.commonDataSource, collectionPath, "asset", "symbol", "symbol", data.Symbol.ValueString(), &api, &resp.Diagnostics)
+	} else {
+		ok = lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "asset", data.ID, data.Name, &api, &resp.Diagnostics)
+	}
+	if !ok {
+		return
+	}
+
+	api.toDatasourceData(&data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,105 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var wmsAssetDataStep1 = `
+data "kaleido_platform_wms_asset" "asset1" {
+    environment = "env1"
+    service = "service1"
+    symbol = "GLD"
+}
+`
+
+var wmsAssetDataStep2 = `
+data "kaleido_platform_wms_asset" "asset1" {
+    environment = "env1"
+    service = "service1"
+    symbol = "SLV"
+}
+`
+
+func TestWMSAssetData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.wmsAssets["env1/service1/asset1"] = &WMSAssetAPIModel{
+		ID:                    "asset1",
+		Name:                  "Gold",
+		Symbol:                "GLD",
+		ProtocolID:            "0x4a7e1f0b5b4e4c3e8f5d2a9c6b1e7d3f0a2c4e6b",
+		AccountIdentifierType: "eth_address",
+		Color:                 "#FFD700",
+		Config:                map[string]any{"units": []any{map[string]any{"name": "oz", "factor": 18}}},
+	}
+	mp.wmsAssets["env1/service1/asset2"] = &WMSAssetAPIModel{ID: "asset2", Name: "Gold Reserve", Symbol: "GLDR"}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/assets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/assets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/assets",
+		})
+		mp.server.Close()
+	}()
+
+	assetData := "data.kaleido_platform_wms_asset.asset1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + wmsAssetDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(assetData, "id", "asset1"),
+					resource.TestCheckResourceAttr(assetData, "name", "Gold"),
+					resource.TestCheckResourceAttr(assetData, "protocol_id", "0x4a7e1f0b5b4e4c3e8f5d2a9c6b1e7d3f0a2c4e6b"),
+					resource.TestCheckResourceAttr(assetData, "account_identifier_type", "eth_address"),
+					resource.TestCheckResourceAttr(assetData, "color", "#FFD700"),
+					resource.TestCheckResourceAttr(assetData, "config_json", `{"units":[{"factor":18,"name":"oz"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestWMSAssetDataNotFound(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/assets",
+		})
+		mp.server.Close()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + wmsAssetDataStep2,
+				ExpectError: regexp.MustCompile(`No asset with symbol 'SLV' was found`),
+			},
+		},
+	})
+}
+
+func (mp *mockPlatform) listWMSAssets(res http.ResponseWriter, req *http.Request) {
+	respondList(mp, res, req, mp.wmsAssets, mux.Vars(req)["env"]+"/"+mux.Vars(req)["service"]+"/")
+}
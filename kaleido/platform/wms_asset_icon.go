@@ -14,15 +14,11 @@
 package platform
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -123,51 +119,22 @@ func (r *wmsAssetIconResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	defer file.Close()
 
-	// Create multipart form data
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// Add the "type" field to the multipart form data
-	if err := w.WriteField("type", data.FileType.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Form creation error", fmt.Sprintf("Could not add type field: %v", err))
-		return
-	}
-
-	// Add the file field
-	fw, err := w.CreateFormFile("file", filepath.Base(filePath))
-	if err != nil {
-		resp.Diagnostics.AddError("Form creation error", fmt.Sprintf("Could not create form file: %v", err))
-		return
-	}
-	_, err = io.Copy(fw, file)
-	if err != nil {
-		resp.Diagnostics.AddError("File copy error", fmt.Sprintf("Could not copy file data: %v", err))
-		return
+	form := &multipartFormBody{
+		Fields: map[string]string{
+			"type": data.FileType.ValueString(),
+		},
+		Files: []multipartFile{{
+			FieldName: "file",
+			FileName:  filepath.Base(filePath),
+			Content:   file,
+		}},
 	}
 
-	w.Close()
-
-	// Make the API request using the common method but with custom multipart handling
 	path := r.apiPath(&data)
 
 	tflog.Debug(ctx, fmt.Sprintf("Uploading icon for asset %s from file %s", data.AssetName.ValueString(), filePath))
 
-	// Use the common requestor but with multipart form data
-	req_resty := r.Platform.R().
-		SetContext(ctx).
-		SetDoNotParseResponse(true).
-		SetHeader("Content-Type", w.FormDataContentType()).
-		SetBody(b.Bytes())
-
-	res, err := req_resty.Post(path)
-	if err != nil {
-		resp.Diagnostics.AddError("API request failed", fmt.Sprintf("Could not upload icon: %v", err))
-		return
-	}
-
-	if !res.IsSuccess() || res.StatusCode() != 204 {
-		body, _ := io.ReadAll(res.RawBody())
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("Upload failed with status %d: %s", res.StatusCode(), string(body)))
+	if ok, _ := r.apiRequest(ctx, http.MethodPost, path, form, nil, &resp.Diagnostics); !ok {
 		return
 	}
 
@@ -213,15 +180,9 @@ func (r *wmsAssetIconResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *wmsAssetIconResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: environment/service/asset_name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be in format: environment/service/asset_name")
+	importStateFromID(ctx, req, resp, "environment", "service", "asset_name")
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("asset_name"), parts[2])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
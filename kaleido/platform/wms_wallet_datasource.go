@@ -0,0 +1,144 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WMSWalletDatasourceModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	Environment types.String                  `tfsdk:"environment"`
+	Service     types.String                  `tfsdk:"service"`
+	Name        types.String                  `tfsdk:"name"`
+	Color       types.String                  `tfsdk:"color"`
+	IconID      types.String                  `tfsdk:"icon_id"`
+	Config      *WMSWalletConfigResourceModel `tfsdk:"config"`
+}
+
+func (api *WMSWalletAPIModel) toDatasourceData(ctx context.Context, data *WMSWalletDatasourceModel, diagnostics *diag.Diagnostics) {
+	var res WMSWalletResourceModel
+	api.toData(ctx, &res, diagnostics)
+	data.ID = res.ID
+	data.Name = res.Name
+	data.Color = res.Color
+	data.IconID = types.StringValue(res.IconID.ValueString())
+	data.Config = res.Config
+}
+
+func WMSWalletDatasourceModelFactory() datasource.DataSource {
+	return &wms_walletDatasource{}
+}
+
+type wms_walletDatasource struct {
+	commonDataSource
+}
+
+func (r *wms_walletDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "kaleido_platform_wms_wallet"
+}
+
+func (r *wms_walletDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing wallet in a wallet manager by name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"environment": &schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+			},
+			"service": &schema.StringAttribute{
+				Required:    true,
+				Description: "Wallet Management Service ID",
+			},
+			"id": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wallet ID. Exactly one of `id` or `name` must be set",
+			},
+			"name": &schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wallet Name. Exactly one of `id` or `name` must be set",
+			},
+			"color": &schema.StringAttribute{
+				Computed: true,
+			},
+			"icon_id": &schema.StringAttribute{
+				Computed: true,
+			},
+			"config": &schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The wallet configuration",
+				Attributes: map[string]schema.Attribute{
+					"type": &schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of configuration for the wallet",
+					},
+					"kms": &schema.SingleNestedAttribute{
+						Computed:    true,
+						Description: "Configuration for wallets of type 'kms'",
+						Attributes: map[string]schema.Attribute{
+							"key_id": &schema.StringAttribute{
+								Computed:    true,
+								Description: "Key URI identifier for the key to resolve in the Kaleido KMS",
+							},
+						},
+					},
+					"readonly": &schema.SingleNestedAttribute{
+						Computed:    true,
+						Description: "Configuration for wallets of type 'readonly'",
+						Attributes: map[string]schema.Attribute{
+							"identifier_map": &schema.MapAttribute{
+								Computed:    true,
+								ElementType: types.StringType,
+								Description: "Static map of types of public identifier, such as 'eth_address', to the account associated with this wallet in any supported blockchains",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *wms_walletDatasource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (r *wms_walletDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WMSWalletDatasourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api WMSWalletAPIModel
+	collectionPath := fmt.Sprintf("/endpoint/%s/%s/rest/api/v1/wallets", data.Environment.ValueString(), data.Service.ValueString())
+	if !lookupByNameOrID(ctx, &r.commonDataSource, collectionPath, "wallet", data.ID, data.Name, &api, &resp.Diagnostics) {
+		return
+	}
+
+	api.toDatasourceData(ctx, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
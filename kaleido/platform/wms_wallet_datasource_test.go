@@ -0,0 +1,68 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var wmsWalletDataStep1 = `
+data "kaleido_platform_wms_wallet" "wallet1" {
+    environment = "env1"
+    service = "service1"
+    name = "treasury"
+}
+`
+
+func TestWMSWalletData(t *testing.T) {
+	mp, providerConfig := testSetup(t)
+	mp.wmsWallets["env1/service1/wallet1"] = &WMSWalletAPIModel{
+		ID:    "wallet1",
+		Name:  "treasury",
+		Color: "#336699",
+		Config: WMSWalletConfigAPIModel{
+			Type: "kms",
+			KMS:  &WMSWalletConfigKMSAPIModel{KeyID: "hdwallet://treasury/0"},
+		},
+	}
+	defer func() {
+		mp.checkClearCalls([]string{
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+			"GET /endpoint/{env}/{service}/rest/api/v1/wallets",
+		})
+		mp.server.Close()
+	}()
+
+	walletData := "data.kaleido_platform_wms_wallet.wallet1"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + wmsWalletDataStep1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(walletData, "id", "wallet1"),
+					resource.TestCheckResourceAttr(walletData, "color", "#336699"),
+					resource.TestCheckResourceAttr(walletData, "icon_id", ""),
+					resource.TestCheckResourceAttr(walletData, "config.type", "kms"),
+					resource.TestCheckResourceAttr(walletData, "config.kms.key_id", "hdwallet://treasury/0"),
+					resource.TestCheckNoResourceAttr(walletData, "config.readonly"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+// normalizeYAML decodes every document in a YAML stream into a form that can be compared
+// with reflect.DeepEqual. Comments, key order, indentation and quoting style are not
+// part of the decoded form, while the scalar types the YAML resolves to are.
+func normalizeYAML(s string) ([]any, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(s))
+	docs := []any{}
+	for {
+		var v any
+		err := decoder.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, v)
+	}
+}
+
+// yamlSemanticEqual compares two YAML documents structurally. Strings that are not
+// valid YAML are only equal if they are identical.
+func yamlSemanticEqual(a, b string) bool {
+	va, errA := normalizeYAML(a)
+	vb, errB := normalizeYAML(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// yamlStringType is a Terraform Plugin Framework custom string type for attributes
+// holding a YAML document. Semantic equality compares the decoded documents, so
+// comments, key order and quoting style do not show as a change once applied.
+type yamlStringType struct {
+	basetypes.StringType
+}
+
+func (t yamlStringType) Equal(o attr.Type) bool {
+	other, ok := o.(yamlStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t yamlStringType) String() string {
+	return "yamlStringType"
+}
+
+func (t yamlStringType) ValueType(_ context.Context) attr.Value {
+	return yamlStringVal{}
+}
+
+func (t yamlStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return yamlStringVal{StringValue: in}, nil
+}
+
+func (t yamlStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T returned by StringType.ValueFromTerraform", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+type yamlStringVal struct {
+	basetypes.StringValue
+}
+
+func newYAMLString(s string) yamlStringVal {
+	return yamlStringVal{StringValue: types.StringValue(s)}
+}
+
+func (v yamlStringVal) Type(_ context.Context) attr.Type {
+	return yamlStringType{}
+}
+
+func (v yamlStringVal) Equal(o attr.Value) bool {
+	other, ok := o.(yamlStringVal)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// SemanticallyEqual reports whether two known values hold structurally equal YAML,
+// for resources that need to skip creating a new version on a no-op reformat.
+func (v yamlStringVal) SemanticallyEqual(o yamlStringVal) bool {
+	if v.IsNull() || v.IsUnknown() || o.IsNull() || o.IsUnknown() {
+		return false
+	}
+	return yamlSemanticEqual(v.ValueString(), o.ValueString())
+}
+
+func (v yamlStringVal) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(yamlStringVal)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("expected value type %T, got %T", v, newValuable),
+		)
+		return false, diags
+	}
+	if v.IsNull() != newValue.IsNull() || v.IsUnknown() != newValue.IsUnknown() {
+		return false, diags
+	}
+	if v.IsNull() || v.IsUnknown() {
+		return true, diags
+	}
+	return yamlSemanticEqual(v.ValueString(), newValue.ValueString()), diags
+}
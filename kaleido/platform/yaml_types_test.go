@@ -0,0 +1,123 @@
+// Copyright © Kaleido, Inc. 2026
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+
+//     http://www.apache.org/licenses/LICENSE-2.0
+
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package platform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAMLStringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{
+			name: "comments",
+			a:    "# the task\nsteps:\n  - name: step1 # first\n",
+			b:    "steps:\n  - name: step1\n",
+			want: true,
+		},
+		{
+			name: "key order",
+			a:    "a: 1\nb:\n  c: true\n  d: x\n",
+			b:    "b:\n  d: x\n  c: true\na: 1\n",
+			want: true,
+		},
+		{
+			name: "quoting style",
+			a:    "name: step1\ndescription: 'some stuff'\n",
+			b:    "\"name\": \"step1\"\ndescription: some stuff\n",
+			want: true,
+		},
+		{
+			name: "flow and block style",
+			a:    "steps: [{name: step1, things: stuff}]\n",
+			b:    "steps:\n- name: step1\n  things: stuff\n",
+			want: true,
+		},
+		{
+			name: "JSON is YAML",
+			a:    `{"steps":[{"name":"step1"}]}`,
+			b:    "steps:\n  - name: step1\n",
+			want: true,
+		},
+		{
+			name: "sequence order is significant",
+			a:    "steps: [a, b]\n",
+			b:    "steps: [b, a]\n",
+			want: false,
+		},
+		{
+			name: "number is not equal to quoted string",
+			a:    "a: 1\n",
+			b:    "a: \"1\"\n",
+			want: false,
+		},
+		{
+			name: "value changed",
+			a:    "steps:\n  - name: step1\n",
+			b:    "steps:\n  - name: step2\n",
+			want: false,
+		},
+		{
+			name: "additional document",
+			a:    "a: 1\n",
+			b:    "a: 1\n---\nb: 2\n",
+			want: false,
+		},
+		{
+			name: "invalid YAML only equal if identical",
+			a:    "a: [",
+			b:    "a: [",
+			want: true,
+		},
+		{
+			name: "invalid YAML not equal to different string",
+			a:    "a: [",
+			b:    "a: []",
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eq, diags := newYAMLString(tc.a).StringSemanticEquals(ctx, newYAMLString(tc.b))
+			require.False(t, diags.HasError())
+			assert.Equal(t, tc.want, eq)
+		})
+	}
+}
+
+func TestYAMLStringSemanticEquals_NullVsValue(t *testing.T) {
+	ctx := context.Background()
+	null := yamlStringVal{StringValue: types.StringNull()}
+	val := newYAMLString("a: 1")
+	eq, diags := null.StringSemanticEquals(ctx, val)
+	require.False(t, diags.HasError())
+	assert.False(t, eq)
+
+	eq, diags = null.StringSemanticEquals(ctx, yamlStringVal{StringValue: types.StringNull()})
+	require.False(t, diags.HasError())
+	assert.True(t, eq)
+
+	// Unlike semantic equality, a null prior value is never treated as unchanged
+	assert.False(t, null.SemanticallyEqual(val))
+	assert.True(t, newYAMLString("a: 1 # one").SemanticallyEqual(val))
+}
@@ -16,6 +16,7 @@ package kaleido
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,6 +32,10 @@ type baasBaseDatasource struct {
 	*kaleidobase.ProviderData
 }
 
+type baasBaseAction struct {
+	*kaleidobase.ProviderData
+}
+
 func (r *baasBaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.ProviderData = kaleidobase.ConfigureProviderData(req.ProviderData, &resp.Diagnostics)
 }
@@ -39,6 +44,10 @@ func (d *baasBaseDatasource) Configure(_ context.Context, req datasource.Configu
 	d.ProviderData = kaleidobase.ConfigureProviderData(req.ProviderData, &resp.Diagnostics)
 }
 
+func (a *baasBaseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.ProviderData = kaleidobase.ConfigureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
 func newTestProviderData() *kaleidobase.ProviderData {
 	return kaleidobase.NewProviderData(context.Background(), &kaleidobase.ProviderModel{})
 }
@@ -63,6 +72,12 @@ func New(version string) func() provider.Provider {
 			append([]func() datasource.DataSource{
 				DatasourcePrivateStackBridgeFactory,
 			}, platform.DataSources()...),
+			platform.EphemeralResources(),
+			platform.Functions(),
+			append([]func() action.Action{
+				ActionServiceResetFactory,
+			}, platform.Actions()...),
+			platform.ListResources(),
 		)
 	}
 }
@@ -187,7 +187,7 @@ func (r *resourceMembership) Delete(ctx context.Context, req resource.DeleteRequ
 	consortiumID := data.ConsortiumID.ValueString()
 	membershipID := data.ID.ValueString()
 
-	err := kaleidobase.Retry.Do(ctx, "Delete", func(attempt int) (retry bool, err error) {
+	err := r.Retry(kaleidobase.DefaultRetry).Do(ctx, "Delete", func(attempt int) (retry bool, err error) {
 		res, deleteErr := r.BaaS.DeleteMembership(consortiumID, membershipID)
 		if deleteErr != nil {
 			return false, deleteErr
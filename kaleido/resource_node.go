@@ -152,7 +152,7 @@ func (r *resourceNode) Schema(_ context.Context, _ resource.SchemaRequest, resp
 }
 
 func (r *resourceNode) waitUntilNodeStarted(ctx context.Context, op, consortiumID, environmentID, nodeID string, apiModel *kaleido.Node, data *NodeResourceModel, diagnostics *diag.Diagnostics) error {
-	return kaleidobase.Retry.Do(ctx, op, func(attempt int) (retry bool, err error) {
+	return r.Retry(kaleidobase.DefaultRetry).Do(ctx, op, func(attempt int) (retry bool, err error) {
 		res, getErr := r.BaaS.GetNode(consortiumID, environmentID, nodeID, apiModel)
 		if getErr != nil {
 			return false, getErr
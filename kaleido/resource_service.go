@@ -134,7 +134,7 @@ func (r *resourceService) Schema(_ context.Context, _ resource.SchemaRequest, re
 }
 
 func (r *resourceService) waitUntilServiceStarted(ctx context.Context, op, consortiumID, environmentID, serviceID string, apiModel *kaleido.Service, data *ServiceResourceModel, diagnostics *diag.Diagnostics) error {
-	return kaleidobase.Retry.Do(ctx, op, func(attempt int) (retry bool, err error) {
+	return r.Retry(kaleidobase.DefaultRetry).Do(ctx, op, func(attempt int) (retry bool, err error) {
 		res, getErr := r.BaaS.GetService(consortiumID, environmentID, serviceID, apiModel)
 		if getErr != nil {
 			return false, getErr